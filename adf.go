@@ -0,0 +1,98 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// apiV3Path is the root of REST API v3, which some endpoints and body
+// formats require regardless of the configured API version.
+const apiV3Path = "rest/api/3/"
+
+// apiV2Path is the root of REST API v2. Methods that send or decode plain
+// text comment bodies use it regardless of the configured API version, as
+// v3 only accepts and returns Atlassian Document Format there.
+const apiV2Path = "rest/api/2/"
+
+// ADFNode is a node of an Atlassian Document Format document, the rich text
+// format Jira Cloud's API v3 uses for comment bodies and descriptions.
+// Documents are built with ADFDoc, ADFParagraph and the text helpers:
+//
+//	doc := jira.ADFDoc(jira.ADFParagraph(
+//		jira.ADFPlain("See "),
+//		jira.ADFLink("the runbook", "https://example.com/runbook"),
+//		jira.ADFPlain(", it is "),
+//		jira.ADFBold("important"),
+//	))
+type ADFNode struct {
+	Type    string    `json:"type"`
+	Version int       `json:"version,omitempty"`
+	Text    string    `json:"text,omitempty"`
+	Marks   []ADFMark `json:"marks,omitempty"`
+	Content []ADFNode `json:"content,omitempty"`
+}
+
+// ADFMark is formatting applied to a text node, e.g. "strong" or "link".
+type ADFMark struct {
+	Type  string            `json:"type"`
+	Attrs map[string]string `json:"attrs,omitempty"`
+}
+
+// ADFDoc returns a document made of the given block nodes, typically
+// paragraphs.
+func ADFDoc(content ...ADFNode) ADFNode {
+	return ADFNode{Type: "doc", Version: 1, Content: content}
+}
+
+// ADFParagraph returns a paragraph made of the given text nodes.
+func ADFParagraph(content ...ADFNode) ADFNode {
+	return ADFNode{Type: "paragraph", Content: content}
+}
+
+// ADFPlain returns an unformatted text node.
+func ADFPlain(text string) ADFNode {
+	return ADFNode{Type: "text", Text: text}
+}
+
+// ADFBold returns a bold text node.
+func ADFBold(text string) ADFNode {
+	return ADFNode{Type: "text", Text: text,
+		Marks: []ADFMark{{Type: "strong"}}}
+}
+
+// ADFLink returns a text node linking to href.
+func ADFLink(text string, href string) ADFNode {
+	return ADFNode{Type: "text", Text: text,
+		Marks: []ADFMark{{Type: "link", Attrs: map[string]string{"href": href}}}}
+}
+
+// ADFText returns a document holding s as plain text, one paragraph per
+// line.
+func ADFText(s string) interface{} {
+	lines := strings.Split(s, "\n")
+	paragraphs := make([]ADFNode, 0, len(lines))
+	for _, line := range lines {
+		if line == "" {
+			paragraphs = append(paragraphs, ADFParagraph())
+			continue
+		}
+		paragraphs = append(paragraphs, ADFParagraph(ADFPlain(line)))
+	}
+
+	return ADFDoc(paragraphs...)
+}
+
+// CommentADF comments on issue with doc, an ADF document, as the body. It
+// always uses API v3, since v2 only accepts plain text bodies.
+func (client *Client) CommentADF(issue string, doc interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{"body": doc})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.request(context.Background(), "POST", apiV3Path,
+		escapePath("issue", issue, "comment"), body, nil)
+
+	return err
+}
@@ -0,0 +1,137 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// agilePath is the root of the Jira Software (agile) REST API below the
+// instance's base URL.
+const agilePath = "rest/agile/1.0/"
+
+// Sprint is a Jira Software sprint. State is "future", "active" or
+// "closed"; the dates are empty until the sprint is started.
+type Sprint struct {
+	Id        int    `json:"id"`
+	Name      string `json:"name"`
+	State     string `json:"state"`
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+	GoalText  string `json:"goal"`
+}
+
+// Board is a Jira Software board. Type is "scrum" or "kanban".
+type Board struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// agileRequest is like Request but for paths below the agile API root.
+func (client *Client) agileRequest(method string, path string,
+	body []byte) ([]byte, error) {
+	return client.request(context.Background(), method, agilePath, path,
+		body, nil)
+}
+
+// GetSprint returns the sprint with the given id.
+func (client *Client) GetSprint(sprintId int) (*Sprint, error) {
+	response, err := client.agileRequest("GET",
+		"sprint/"+strconv.Itoa(sprintId), []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	sprint := &Sprint{}
+	if err := json.Unmarshal(response, sprint); err != nil {
+		return nil, err
+	}
+
+	return sprint, nil
+}
+
+// GetSprintIssues returns all issues in the sprint, fetching as many pages
+// as needed.
+func (client *Client) GetSprintIssues(sprintId int, fields []string) (
+	[]*Issue, error) {
+	return client.agileIssues("sprint/"+strconv.Itoa(sprintId)+"/issue",
+		fields)
+}
+
+// GetBoards returns one page of the boards of the project projectKeyOrId
+// (all boards if empty) along with the total number of boards.
+func (client *Client) GetBoards(projectKeyOrId string, startAt int,
+	maxResults int) ([]Board, int, error) {
+	query := url.Values{
+		"startAt":    {strconv.Itoa(startAt)},
+		"maxResults": {strconv.Itoa(maxResults)},
+	}
+	if projectKeyOrId != "" {
+		query.Set("projectKeyOrId", projectKeyOrId)
+	}
+
+	response, err := client.agileRequest("GET", "board?"+query.Encode(),
+		[]byte{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var rawData struct {
+		Total  int     `json:"total"`
+		Values []Board `json:"values"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return nil, 0, err
+	}
+
+	return rawData.Values, rawData.Total, nil
+}
+
+// GetBoardBacklog returns all issues in the backlog of the board, fetching
+// as many pages as needed.
+func (client *Client) GetBoardBacklog(boardId int, fields []string) (
+	[]*Issue, error) {
+	return client.agileIssues("board/"+strconv.Itoa(boardId)+"/backlog",
+		fields)
+}
+
+// agileIssues fetches every page of the paginated issue list at path below
+// the agile API root.
+func (client *Client) agileIssues(path string, fields []string) (
+	[]*Issue, error) {
+	issues := []*Issue{}
+	for {
+		query := url.Values{
+			"startAt": {strconv.Itoa(len(issues))},
+			"fields":  {strings.Join(fields, ",")},
+		}
+		response, err := client.agileRequest("GET", path+"?"+query.Encode(),
+			[]byte{})
+		if err != nil {
+			return nil, err
+		}
+
+		var rawData struct {
+			Total  int                      `json:"total"`
+			Issues []map[string]interface{} `json:"issues"`
+		}
+		if err := json.Unmarshal(response, &rawData); err != nil {
+			return nil, err
+		}
+
+		for _, rawIssue := range rawData.Issues {
+			issue, err := newIssue(rawIssue)
+			if err != nil {
+				return nil, err
+			}
+			issues = append(issues, issue)
+		}
+
+		if len(rawData.Issues) == 0 || len(issues) >= rawData.Total {
+			return issues, nil
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// API is the set of Jira operations implemented by *Client. Code that talks
+// to Jira can accept an API instead of a *Client so it can be tested with a
+// fake. Client configuration (the Set* methods) is not part of it.
+type API interface {
+	Request(method string, path string, body []byte) ([]byte, error)
+	RequestWithContext(ctx context.Context, method string, path string,
+		body []byte) ([]byte, error)
+	RequestWithHeaders(method string, path string, body []byte,
+		headers http.Header) ([]byte, error)
+	RequestWithHeadersContext(ctx context.Context, method string,
+		path string, body []byte, headers http.Header) ([]byte, error)
+	RequestStream(method string, path string, body []byte) (io.ReadCloser,
+		http.Header, error)
+	RequestStreamWithContext(ctx context.Context, method string, path string,
+		body []byte) (io.ReadCloser, http.Header, error)
+	Get(path string, v interface{}) error
+	GetContext(ctx context.Context, path string, v interface{}) error
+	Post(path string, body interface{}, v interface{}) error
+	PostContext(ctx context.Context, path string, body interface{},
+		v interface{}) error
+	Put(path string, body interface{}, v interface{}) error
+	PutContext(ctx context.Context, path string, body interface{},
+		v interface{}) error
+	Delete(path string) error
+	DeleteContext(ctx context.Context, path string) error
+
+	Ping() error
+	ServerInfo() (*ServerInfo, error)
+	Myself() (*User, error)
+	MyTimeZone() (*time.Location, error)
+	ResolveAccountId(usernameOrEmail string) (string, error)
+	FindAssignableUsers(query string, projectKey string, issueKey string,
+		maxResults int) ([]User, error)
+
+	GetIssue(key string, fields []string) (*Issue, error)
+	GetIssueContext(ctx context.Context, key string, fields []string) (
+		*Issue, error)
+	GetIssueExpanded(key string, fields []string, expand []string) (
+		*Issue, error)
+	GetIssueExpandedContext(ctx context.Context, key string,
+		fields []string, expand []string) (*Issue, error)
+	GetIssueInto(key string, fields []string, v interface{}) error
+	CreateIssue(project string, issuetype string, summary string,
+		fields map[string]interface{}) (*Issue, error)
+	CreateIssueContext(ctx context.Context, project string,
+		issuetype string, summary string, fields map[string]interface{}) (
+		*Issue, error)
+	ValidateIssue(project string, issuetype string, summary string,
+		fields map[string]interface{}) error
+	CreateSubtask(parentKey string, summary string, issuetype string,
+		fields map[string]interface{}) (*Issue, error)
+	UpdateIssue(key string, fields map[string]interface{}) error
+	UpdateIssueContext(ctx context.Context, key string,
+		fields map[string]interface{}) error
+	GetEditMeta(key string) (map[string]FieldMeta, error)
+	ClearFields(key string, fieldNames ...string) error
+	SetDueDate(key string, due time.Time) error
+	UpdateWhere(jql string, fields map[string]interface{},
+		concurrency int) (updated int, errs map[string]error, err error)
+	UpdateWhereContext(ctx context.Context, jql string,
+		fields map[string]interface{}, concurrency int) (updated int,
+		errs map[string]error, err error)
+	GetSecurityLevels(projectKey string) ([]SecurityLevel, error)
+	SetSecurityLevel(key string, levelName string) error
+	AssignIssue(key string, accountId string) error
+	DeleteIssue(key string, deleteSubtasks bool) error
+	AssignIssueContext(ctx context.Context, key string,
+		accountId string) error
+	DeleteIssueContext(ctx context.Context, key string,
+		deleteSubtasks bool) error
+	ExportIssue(key string) (*IssueExport, error)
+	ArchiveIssue(key string) error
+	UnarchiveIssue(key string) error
+	ArchiveIssues(keys []string) (archivedCount int, failures []string,
+		err error)
+	GetIssueChangelog(key string, startAt int, maxResults int) (
+		[]ChangelogEntry, int, error)
+	AddLabels(key string, labels ...string) error
+	RemoveLabels(key string, labels ...string) error
+	ModifyLabels(key string, add []string, remove []string) error
+	ModifyLabelsContext(ctx context.Context, key string, add []string,
+		remove []string) error
+
+	Search(jql string, fields []string, startAt int, maxResults int) (
+		[]*Issue, int, error)
+	SearchContext(ctx context.Context, jql string, fields []string,
+		startAt int, maxResults int) ([]*Issue, int, error)
+	SearchGET(jql string, fields []string, startAt int, maxResults int) (
+		[]*Issue, int, error)
+	SearchAll(ctx context.Context, jql string, fields []string,
+		pageSize int) (<-chan *Issue, <-chan error)
+	SearchV3(jql string, fields []string, nextPageToken string,
+		maxResults int) (issues []*Issue, next string, err error)
+	BulkGetIssues(keys []string, fields []string) ([]*Issue, error)
+	FindOne(jql string, fields []string) (*Issue, error)
+	GetFilter(filterId string) (*Filter, error)
+	SearchByFilter(filterId string, fields []string, startAt int,
+		maxResults int) ([]*Issue, int, error)
+	PickIssues(query string, currentProjectId string) (
+		[]IssuePickerSuggestion, error)
+
+	Comment(issue string, msg string) error
+	CommentContext(ctx context.Context, issue string, msg string) error
+	CommentWithResult(issue string, msg string) (*Comment, error)
+	CommentWithResultContext(ctx context.Context, issue string,
+		msg string) (*Comment, error)
+	CommentADF(issue string, doc interface{}) error
+	CommentBatch(issue string, bodies []string) ([]error, error)
+	CommentBatchContext(ctx context.Context, issue string, bodies []string) (
+		[]error, error)
+	CommentWithVisibility(issue string, msg string, visibilityType string,
+		visibilityValue string) error
+	CommentWithVisibilityContext(ctx context.Context, issue string,
+		msg string, visibilityType string, visibilityValue string) error
+	GetComment(issue string, commentId string) (*Comment, error)
+	GetComments(issue string, startAt int, maxResults int) ([]Comment, int,
+		error)
+	UpdateComment(issue string, commentId string, msg string) error
+	DeleteComment(issue string, commentId string) error
+	UpdateCommentContext(ctx context.Context, issue string,
+		commentId string, msg string) error
+	DeleteCommentContext(ctx context.Context, issue string,
+		commentId string) error
+
+	GetTransitions(key string) ([]Transition, error)
+	GetTransitionsExpanded(key string) ([]Transition, error)
+	DoTransition(key string, transitionId string,
+		fields map[string]interface{}) error
+	TransitionWithComment(key string, transitionId string, comment string,
+		fields map[string]interface{}) error
+	TransitionByName(key string, name string) error
+	DoTransitionContext(ctx context.Context, key string,
+		transitionId string, fields map[string]interface{}) error
+	TransitionWithCommentContext(ctx context.Context, key string,
+		transitionId string, comment string,
+		fields map[string]interface{}) error
+	TransitionByNameContext(ctx context.Context, key string,
+		name string) error
+	TransitionMany(keys []string, transitionName string,
+		fields map[string]interface{}) (map[string]error, error)
+	TransitionManyContext(ctx context.Context, keys []string,
+		transitionName string, fields map[string]interface{}) (
+		map[string]error, error)
+	CloseIssue(key string, resolution string) error
+
+	AddAttachment(issue string, filename string, content io.Reader) (
+		*Attachment, error)
+	GetAttachmentContent(attachmentId string) (io.ReadCloser, error)
+	DownloadAttachments(issue string, destDir string) ([]string, error)
+
+	AddWorklog(issue string, timeSpentSeconds int, started time.Time,
+		comment string) (*Worklog, error)
+	AddWorklogContext(ctx context.Context, issue string,
+		timeSpentSeconds int, started time.Time, comment string) (*Worklog,
+		error)
+	GetWorklogs(issue string) ([]Worklog, error)
+	WorklogsUpdatedSince(since time.Time) ([]WorklogRef, error)
+	GetWorklogsByIds(ids []int) ([]Worklog, error)
+
+	GetIssueLinkTypes() ([]LinkType, error)
+	LinkIssues(inwardKey string, outwardKey string, linkType string) error
+	AddRemoteLink(issue string, linkUrl string, title string,
+		opts ...RemoteLinkOption) (string, error)
+	GetRemoteLinks(issue string) ([]RemoteLink, error)
+
+	AddWatcher(issue string, accountId string) error
+	RemoveWatcher(issue string, accountId string) error
+	AddWatcherContext(ctx context.Context, issue string,
+		accountId string) error
+	RemoveWatcherContext(ctx context.Context, issue string,
+		accountId string) error
+	GetWatchers(issue string) ([]User, error)
+	WatcherCount(issue string) (int, error)
+	AddVote(issue string) error
+	RemoveVote(issue string) error
+	AddVoteContext(ctx context.Context, issue string) error
+	RemoveVoteContext(ctx context.Context, issue string) error
+	GetVotes(issue string) (count int, hasVoted bool, err error)
+
+	GetProject(key string) (*Project, error)
+	GetProjects(keys []string) (map[string]*Project, error)
+	GetProjectContext(ctx context.Context, key string) (*Project, error)
+	GetProjectTitle(key string) (string, error)
+	GetProjectTitleContext(ctx context.Context, key string) (string, error)
+	ListProjects(startAt int, maxResults int) ([]*Project, int, error)
+	GetProjectRoles(projectKey string) (map[string]string, error)
+	GetProjectRoleMembers(projectKey string, roleId string) ([]Actor, error)
+	GetProjectComponents(projectKey string) ([]Component, error)
+	CreateComponent(projectKey string, name string, leadAccountId string) (
+		*Component, error)
+	GetProjectVersions(projectKey string) ([]Version, error)
+	CreateVersion(projectKey string, name string, opts ...VersionOption) (
+		*Version, error)
+	ReleaseVersion(versionId string, releaseDate time.Time) error
+
+	GetSprint(sprintId int) (*Sprint, error)
+	GetSprintIssues(sprintId int, fields []string) ([]*Issue, error)
+	GetBoards(projectKeyOrId string, startAt int, maxResults int) ([]Board,
+		int, error)
+	GetBoardBacklog(boardId int, fields []string) ([]*Issue, error)
+
+	GetIssueTypes() ([]IssueType, error)
+	GetPriorities() ([]Priority, error)
+	GetStatuses() ([]Status, error)
+	GetCreateMeta(projectKey string) ([]IssueType, error)
+	GetFields() ([]Field, error)
+	FieldIdByName(name string) (string, error)
+	CoerceFieldValue(fieldId string, value interface{}) (interface{}, error)
+}
+
+var _ API = (*Client)(nil)
@@ -0,0 +1,90 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ArchiveIssue archives the issue, hiding it from searches and boards
+// without deleting it. Archiving is only available on Jira Cloud.
+func (client *Client) ArchiveIssue(key string) error {
+	return client.archiveOne("archive", key)
+}
+
+// UnarchiveIssue restores an archived issue. It is only available on Jira
+// Cloud.
+func (client *Client) UnarchiveIssue(key string) error {
+	return client.archiveOne("unarchive", key)
+}
+
+// ArchiveIssues archives the issues with the given keys in one request and
+// returns how many were archived. Issues that could not be archived, e.g.
+// subtasks, are reported in failures as "KEY: reason"; they don't make the
+// call fail. Archiving is only available on Jira Cloud.
+func (client *Client) ArchiveIssues(keys []string) (archivedCount int,
+	failures []string, err error) {
+	return client.archive("archive", keys)
+}
+
+func (client *Client) archiveOne(verb string, key string) error {
+	count, failures, err := client.archive(verb, []string{key})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		if len(failures) > 0 {
+			return fmt.Errorf("cannot %s %s", verb, failures[0])
+		}
+		return fmt.Errorf("cannot %s %s", verb, key)
+	}
+
+	return nil
+}
+
+// archive sends keys to the bulk archive or unarchive endpoint, named by
+// verb, and returns the number of issues updated along with the failures.
+func (client *Client) archive(verb string, keys []string) (int, []string,
+	error) {
+	cloud, err := client.isCloud()
+	if err != nil {
+		return 0, nil, err
+	}
+	if !cloud {
+		return 0, nil, fmt.Errorf("issue archiving is only supported on " +
+			"Jira Cloud")
+	}
+
+	body, err := json.Marshal(map[string][]string{"issueIdsOrKeys": keys})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	response, err := client.Request("PUT", "issue/"+verb, body)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var rawData struct {
+		NumberOfIssuesUpdated int `json:"numberOfIssuesUpdated"`
+		Errors                map[string]struct {
+			IssueIdsOrKeys []string `json:"issueIdsOrKeys"`
+			Message        string   `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return 0, nil, err
+	}
+
+	var failures []string
+	for _, failure := range rawData.Errors {
+		message := strings.TrimSuffix(failure.Message, ".")
+		for _, key := range failure.IssueIdsOrKeys {
+			failures = append(failures, key+": "+message)
+		}
+	}
+	sort.Strings(failures)
+
+	return rawData.NumberOfIssuesUpdated, failures, nil
+}
@@ -0,0 +1,222 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Attachment is a file attached to an issue. Content is the URL the file
+// can be downloaded from.
+type Attachment struct {
+	Id       string `json:"id"`
+	Filename string `json:"filename"`
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType"`
+	Content  string `json:"content"`
+}
+
+// AddAttachment uploads content as a file named filename and attaches it to
+// issue. The content is read fully into memory before the upload.
+func (client *Client) AddAttachment(issue string, filename string,
+	content io.Reader) (*Attachment, error) {
+	buffer := &bytes.Buffer{}
+	writer := multipart.NewWriter(buffer)
+
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, content); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", writer.FormDataContentType())
+	header.Set("X-Atlassian-Token", "no-check")
+
+	response, err := client.request(context.Background(), "POST",
+		client.apiPath, escapePath("issue", issue, "attachments"),
+		buffer.Bytes(), header)
+	if err != nil {
+		return nil, err
+	}
+
+	var attachments []Attachment
+	if err := json.Unmarshal(response, &attachments); err != nil {
+		return nil, err
+	}
+	if len(attachments) == 0 {
+		return nil, fmt.Errorf("unexpected response: no attachment returned")
+	}
+
+	return &attachments[0], nil
+}
+
+// GetAttachmentContent returns a stream of the content of the attachment
+// with the given id. The caller must close it. Jira usually redirects the
+// download to a signed URL on another host; redirects are followed, but the
+// credentials are only ever sent to the Jira host itself.
+func (client *Client) GetAttachmentContent(attachmentId string) (
+	io.ReadCloser, error) {
+	response, err := client.Request("GET", escapePath("attachment", attachmentId),
+		[]byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := Attachment{}
+	if err := json.Unmarshal(response, &attachment); err != nil {
+		return nil, err
+	}
+	if attachment.Content == "" {
+		return nil, fmt.Errorf("unexpected response: missing content field")
+	}
+
+	return client.download(attachment.Content)
+}
+
+// download streams the content at contentUrl, following redirects but only
+// sending credentials to the Jira host.
+func (client *Client) download(contentUrl string) (io.ReadCloser, error) {
+	req, err := client.newRequest(context.Background(), "GET", contentUrl,
+		[]byte{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	client.stripForeignCredentials(req)
+
+	httpClient := *client.res
+	httpClient.CheckRedirect = func(req *http.Request,
+		via []*http.Request) error {
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
+		}
+		client.stripForeignCredentials(req)
+		return nil
+	}
+
+	resp, err := client.do(&httpClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := responseBody(resp)
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer body.Close()
+		data, err := client.readAll(body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, newError(resp, data)
+	}
+
+	return body, nil
+}
+
+// stripForeignCredentials removes the credentials and run-as header from req
+// unless it goes to the Jira host, so an attachment URL or redirect pointing
+// elsewhere never receives them.
+func (client *Client) stripForeignCredentials(req *http.Request) {
+	if req.URL.Host != client.baseUrl.Host {
+		req.Header.Del("Authorization")
+		req.Header.Del(client.runAsHeader)
+	}
+}
+
+// DownloadAttachments downloads all attachments of issue into destDir under
+// their original file names and returns the paths of the files written.
+// Names that collide with each other or with existing files get a numeric
+// suffix, so nothing is overwritten. A failed download doesn't stop the
+// others; all failures are reported together in the returned error.
+func (client *Client) DownloadAttachments(issue string, destDir string) (
+	[]string, error) {
+	var rawData struct {
+		Attachment []Attachment `json:"attachment"`
+	}
+	err := client.GetIssueInto(issue, []string{"attachment"}, &rawData)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	var errs []error
+	for _, attachment := range rawData.Attachment {
+		path, err := client.downloadTo(attachment, destDir)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("attachment %s (%s): %w",
+				attachment.Id, attachment.Filename, err))
+			continue
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, errors.Join(errs...)
+}
+
+// downloadTo streams the content of attachment into a new file in destDir.
+func (client *Client) downloadTo(attachment Attachment, destDir string) (
+	string, error) {
+	content, err := client.download(attachment.Content)
+	if err != nil {
+		return "", err
+	}
+	defer content.Close()
+
+	file, err := createUnique(destDir, attachment.Filename)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(file, content); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return "", err
+	}
+
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+
+	return file.Name(), nil
+}
+
+// createUnique creates a new file named like filename in dir, adding a
+// " (n)" suffix to the name if it is taken. Only the base name of filename
+// is used, so it can't point outside dir.
+func createUnique(dir string, filename string) (*os.File, error) {
+	filename = filepath.Base(filepath.Clean("/" + filename))
+	if filename == "/" || filename == "." {
+		filename = "attachment"
+	}
+
+	ext := filepath.Ext(filename)
+	stem := strings.TrimSuffix(filename, ext)
+
+	for n := 1; ; n++ {
+		file, err := os.OpenFile(filepath.Join(dir, filename),
+			os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if !os.IsExist(err) {
+			return file, err
+		}
+		filename = stem + " (" + strconv.Itoa(n) + ")" + ext
+	}
+}
@@ -0,0 +1,73 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetAttachmentContentForeignHost(t *testing.T) {
+	var auth, runAs []string
+	storage := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			auth = append(auth, r.Header.Get("Authorization"))
+			runAs = append(runAs, r.Header.Get("X-Impersonate"))
+			w.Write([]byte("content"))
+		}))
+	defer storage.Close()
+
+	var jira *httptest.Server
+	jira = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/rest/api/2/attachment/1":
+				w.Write([]byte(`{"content": "` + jira.URL +
+					`/secure/attachment/1"}`))
+			case "/rest/api/2/attachment/2":
+				w.Write([]byte(`{"content": "` + storage.URL +
+					`/secure/attachment/2"}`))
+			case "/secure/attachment/1":
+				if r.Header.Get("Authorization") == "" {
+					t.Error("no credentials sent to the Jira host")
+				}
+				http.Redirect(w, r, storage.URL+"/signed/1",
+					http.StatusFound)
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+	defer jira.Close()
+
+	client, err := NewClient(jira.URL, "user", "pass", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetRunAsHeader("X-Impersonate")
+	client.SetRunAs("alice")
+
+	for _, id := range []string{"1", "2"} {
+		content, err := client.GetAttachmentContent(id)
+		if err != nil {
+			t.Fatalf("attachment %s: %v", id, err)
+		}
+		data, err := io.ReadAll(content)
+		content.Close()
+		if err != nil {
+			t.Fatalf("attachment %s: %v", id, err)
+		}
+		if string(data) != "content" {
+			t.Errorf("attachment %s: got %q, want %q", id, data, "content")
+		}
+	}
+
+	if len(auth) != 2 {
+		t.Fatalf("got %d requests to the storage host, want 2", len(auth))
+	}
+	for i := range auth {
+		if auth[i] != "" || runAs[i] != "" {
+			t.Errorf("request %d to the storage host sent Authorization %q "+
+				"and run-as %q", i, auth[i], runAs[i])
+		}
+	}
+}
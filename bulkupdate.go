@@ -0,0 +1,114 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// defaultUpdateWhereLimit is the number of issues UpdateWhere updates at
+// most, unless changed with SetUpdateWhereLimit.
+const defaultUpdateWhereLimit = 100
+
+// updateWherePageSize is the page size UpdateWhere collects the matching
+// issues with.
+const updateWherePageSize = 100
+
+// SetUpdateWhereLimit sets the number of matching issues above which
+// UpdateWhere refuses to update anything, 100 by default, guarding against
+// a mistaken query editing far more issues than intended. Zero or less
+// removes the limit.
+func (client *Client) SetUpdateWhereLimit(limit int) {
+	client.updateWhereLimit = limit
+}
+
+// UpdateWhere sets fields, like UpdateIssue, on every issue matching jql,
+// updating up to concurrency issues at a time, and returns the number of
+// issues updated along with the error of each issue that failed, by key.
+// The matching issues are collected before any is updated, so updates that
+// change whether an issue matches don't affect which issues are updated. If
+// more issues match than the limit set with SetUpdateWhereLimit, nothing is
+// updated and an error is returned.
+func (client *Client) UpdateWhere(jql string, fields map[string]interface{},
+	concurrency int) (updated int, errs map[string]error, err error) {
+	return client.UpdateWhereContext(context.Background(), jql, fields,
+		concurrency)
+}
+
+// UpdateWhereContext is like UpdateWhere. Once ctx is done, no further
+// issues are updated and its error is returned.
+func (client *Client) UpdateWhereContext(ctx context.Context, jql string,
+	fields map[string]interface{}, concurrency int) (updated int,
+	errs map[string]error, err error) {
+	keys, err := client.matchingKeys(ctx, jql)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	todo := make(chan string)
+	go func() {
+		defer close(todo)
+		for _, key := range keys {
+			select {
+			case todo <- key:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs = map[string]error{}
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range todo {
+				err := client.UpdateIssueContext(ctx, key, fields)
+
+				mu.Lock()
+				if err != nil {
+					errs[key] = err
+				} else {
+					updated++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return updated, errs, ctx.Err()
+}
+
+// matchingKeys returns the keys of all issues matching jql, failing if
+// there are more than the UpdateWhere limit.
+func (client *Client) matchingKeys(ctx context.Context, jql string) (
+	[]string, error) {
+	var keys []string
+	for {
+		issues, total, err := client.SearchContext(ctx, jql,
+			[]string{"summary"}, len(keys), updateWherePageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if limit := client.updateWhereLimit; limit > 0 && total > limit {
+			return nil, fmt.Errorf("%d issues match %q, more than the limit "+
+				"of %d", total, jql, limit)
+		}
+
+		for _, issue := range issues {
+			keys = append(keys, issue.Key)
+		}
+		if len(issues) == 0 || len(keys) >= total {
+			return keys, nil
+		}
+	}
+}
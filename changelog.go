@@ -0,0 +1,82 @@
+package jira
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// ChangelogEntry is a single change to an issue, made by Author at Created,
+// that touched one or more fields.
+type ChangelogEntry struct {
+	Id      string       `json:"id"`
+	Author  User         `json:"author"`
+	Created string       `json:"created"`
+	Items   []ChangeItem `json:"items"`
+}
+
+// ChangeItem is the change of a single field within a ChangelogEntry.
+type ChangeItem struct {
+	Field      string `json:"field"`
+	FromString string `json:"fromString"`
+	ToString   string `json:"toString"`
+}
+
+// GetIssueChangelog returns one page of the issue's change history along
+// with the total number of entries. Jira Server has no paginated changelog
+// endpoint, so there the whole history is fetched with the issue and paged
+// locally.
+func (client *Client) GetIssueChangelog(key string, startAt int,
+	maxResults int) ([]ChangelogEntry, int, error) {
+	cloud, err := client.isCloud()
+	if err != nil {
+		return nil, 0, err
+	}
+	if !cloud {
+		return client.getExpandedChangelog(key, startAt, maxResults)
+	}
+
+	query := url.Values{
+		"startAt":    {strconv.Itoa(startAt)},
+		"maxResults": {strconv.Itoa(maxResults)},
+	}
+
+	response, err := client.Request("GET",
+		escapePath("issue", key, "changelog")+"?"+query.Encode(), []byte{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var rawData struct {
+		Total  int              `json:"total"`
+		Values []ChangelogEntry `json:"values"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return nil, 0, err
+	}
+
+	return rawData.Values, rawData.Total, nil
+}
+
+func (client *Client) getExpandedChangelog(key string, startAt int,
+	maxResults int) ([]ChangelogEntry, int, error) {
+	response, err := client.Request("GET",
+		escapePath("issue", key)+"?fields=created&expand=changelog", []byte{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var rawData struct {
+		Changelog struct {
+			Histories []ChangelogEntry `json:"histories"`
+		} `json:"changelog"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return nil, 0, err
+	}
+
+	histories := rawData.Changelog.Histories
+	start, end := pageBounds(startAt, maxResults, len(histories))
+
+	return histories[start:end], len(histories), nil
+}
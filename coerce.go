@@ -0,0 +1,112 @@
+package jira
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// CoerceFieldValue shapes value into the JSON structure Jira expects for
+// the field with the given id (or name), based on the field's schema, so it
+// can be passed to CreateIssue or UpdateIssue. For example, a string becomes
+// {"value": s} for a select list, {"accountId": s} for a user picker on Jira
+// Cloud ({"name": s} on Jira Server) and {"name": s} for a version; a slice
+// is shaped element by element for multi-value fields, and a single value
+// is wrapped in one. Numbers given for select lists are treated as option
+// ids, and time.Time values are formatted for date and datetime fields.
+// Values that are already maps, and nil, are returned as is.
+func (client *Client) CoerceFieldValue(fieldId string, value interface{}) (
+	interface{}, error) {
+	id, err := client.FieldIdByName(fieldId)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := client.cachedFields()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range fields {
+		if field.Id != id {
+			continue
+		}
+
+		if field.Schema.Type != "array" {
+			return client.coerceValue(field.Schema.Type, value)
+		}
+
+		var values []interface{}
+		if rv := reflect.ValueOf(value); value != nil &&
+			(rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) {
+			for i := 0; i < rv.Len(); i++ {
+				values = append(values, rv.Index(i).Interface())
+			}
+		} else if value != nil {
+			values = []interface{}{value}
+		}
+
+		coerced := make([]interface{}, 0, len(values))
+		for _, value := range values {
+			item, err := client.coerceValue(field.Schema.Items, value)
+			if err != nil {
+				return nil, err
+			}
+			coerced = append(coerced, item)
+		}
+		return coerced, nil
+	}
+
+	return nil, fmt.Errorf("unknown field %q", fieldId)
+}
+
+// coerceValue shapes a single value for a field of the given schema type.
+func (client *Client) coerceValue(schemaType string, value interface{}) (
+	interface{}, error) {
+	switch value.(type) {
+	case nil, map[string]interface{}, map[string]string:
+		return value, nil
+	}
+
+	switch schemaType {
+	case "option", "option-with-child":
+		switch v := value.(type) {
+		case string:
+			return map[string]string{"value": v}, nil
+		case int:
+			return map[string]string{"id": strconv.Itoa(v)}, nil
+		case int64:
+			return map[string]string{"id": strconv.FormatInt(v, 10)}, nil
+		}
+
+	case "user":
+		if v, ok := value.(string); ok {
+			return client.assigneePayload(v)
+		}
+
+	case "priority", "version", "component", "resolution", "securitylevel",
+		"group", "issuetype", "project":
+		if v, ok := value.(string); ok {
+			return map[string]string{"name": v}, nil
+		}
+
+	case "date":
+		if v, ok := value.(time.Time); ok {
+			return v.Format(dateLayout), nil
+		}
+		return value, nil
+
+	case "datetime":
+		if v, ok := value.(time.Time); ok {
+			return v.Format(timeLayout), nil
+		}
+		return value, nil
+
+	default:
+		return value, nil
+	}
+
+	return nil, fmt.Errorf("cannot use %v (%T) as a value of type %s", value,
+		value, schemaType)
+}
@@ -0,0 +1,220 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Comment is a comment on an issue. Jira Server and API v2 return the body
+// as plain text, which is stored in Body; Jira Cloud API v3 returns it as an
+// Atlassian Document Format object, which is kept as raw JSON in ADFBody.
+// When encoded, ADFBody is written as "adfBody", which is read back as
+// well, so comments survive a round trip through JSON.
+type Comment struct {
+	Id      string          `json:"id"`
+	Body    string          `json:"body,omitempty"`
+	ADFBody json.RawMessage `json:"adfBody,omitempty"`
+	Author  User            `json:"author"`
+	Created string          `json:"created"`
+	Updated string          `json:"updated"`
+}
+
+func (comment *Comment) UnmarshalJSON(data []byte) error {
+	var rawData struct {
+		Id      string          `json:"id"`
+		Body    json.RawMessage `json:"body"`
+		ADFBody json.RawMessage `json:"adfBody"`
+		Author  User            `json:"author"`
+		Created string          `json:"created"`
+		Updated string          `json:"updated"`
+	}
+	if err := json.Unmarshal(data, &rawData); err != nil {
+		return err
+	}
+
+	*comment = Comment{
+		Id:      rawData.Id,
+		Author:  rawData.Author,
+		Created: rawData.Created,
+		Updated: rawData.Updated,
+	}
+
+	switch {
+	case len(rawData.Body) == 0 || string(rawData.Body) == "null":
+		if len(rawData.ADFBody) > 0 && string(rawData.ADFBody) != "null" {
+			comment.ADFBody = rawData.ADFBody
+		}
+	case rawData.Body[0] == '"':
+		return json.Unmarshal(rawData.Body, &comment.Body)
+	default:
+		comment.ADFBody = rawData.Body
+	}
+
+	return nil
+}
+
+// GetComments returns one page of the comments on issue along with the
+// total number of comments.
+func (client *Client) GetComments(issue string, startAt int,
+	maxResults int) ([]Comment, int, error) {
+	query := url.Values{
+		"startAt":    {strconv.Itoa(startAt)},
+		"maxResults": {strconv.Itoa(maxResults)},
+	}
+
+	response, err := client.Request("GET",
+		escapePath("issue", issue, "comment")+"?"+query.Encode(), []byte{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var rawData struct {
+		Total    int       `json:"total"`
+		Comments []Comment `json:"comments"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return nil, 0, err
+	}
+
+	return rawData.Comments, rawData.Total, nil
+}
+
+// GetComment returns the comment with the given id on issue, as Jira
+// stored it. A missing comment is reported as an Error matching
+// ErrNotFound.
+func (client *Client) GetComment(issue string, commentId string) (*Comment,
+	error) {
+	response, err := client.Request("GET",
+		escapePath("issue", issue, "comment", commentId), []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &Comment{}
+	if err := json.Unmarshal(response, comment); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// UpdateComment replaces the body of an existing comment. A comment that
+// does not exist and one the user may not edit are reported as an Error with
+// StatusCode 404 and 403 respectively.
+func (client *Client) UpdateComment(issue string, commentId string,
+	msg string) error {
+	return client.UpdateCommentContext(context.Background(), issue, commentId,
+		msg)
+}
+
+func (client *Client) UpdateCommentContext(ctx context.Context, issue string,
+	commentId string, msg string) error {
+	type comment struct {
+		Data string `json:"body"`
+	}
+
+	body, err := json.Marshal(comment{Data: msg})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.request(ctx, "PUT", apiV2Path,
+		escapePath("issue", issue, "comment", commentId), body, nil)
+
+	return err
+}
+
+// DeleteComment deletes a comment. As with UpdateComment, a missing comment
+// and a lack of permission are reported as an Error with StatusCode 404 and
+// 403 respectively.
+func (client *Client) DeleteComment(issue string, commentId string) error {
+	return client.DeleteCommentContext(context.Background(), issue, commentId)
+}
+
+func (client *Client) DeleteCommentContext(ctx context.Context, issue string,
+	commentId string) error {
+	_, err := client.RequestWithContext(ctx, "DELETE",
+		escapePath("issue", issue, "comment", commentId), []byte{})
+
+	return err
+}
+
+// CommentWithVisibility comments on issue, restricting the comment to
+// members of a project role or a group. visibilityType must be "role" or
+// "group" and visibilityValue the name of the role or group.
+func (client *Client) CommentWithVisibility(issue string, msg string,
+	visibilityType string, visibilityValue string) error {
+	return client.CommentWithVisibilityContext(context.Background(), issue, msg,
+		visibilityType, visibilityValue)
+}
+
+func (client *Client) CommentWithVisibilityContext(ctx context.Context,
+	issue string, msg string, visibilityType string,
+	visibilityValue string) error {
+	if visibilityType != "role" && visibilityType != "group" {
+		return fmt.Errorf("invalid visibility type %q: must be role or group",
+			visibilityType)
+	}
+
+	type visibility struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	}
+	type comment struct {
+		Data       string     `json:"body"`
+		Visibility visibility `json:"visibility"`
+	}
+
+	body, err := json.Marshal(comment{
+		Data:       msg,
+		Visibility: visibility{Type: visibilityType, Value: visibilityValue},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.request(ctx, "POST", apiV2Path,
+		escapePath("issue", issue, "comment"), body, nil)
+
+	return err
+}
+
+// CommentBatch posts each of bodies as a comment on issue, in order, and
+// returns the error of each post (nil on success) in the order of bodies.
+// Jira has no bulk comment API, so the comments are posted one by one. The
+// batch is aborted on a 401 response, as further posts would fail too; the
+// error that aborted it is then returned as the second result and recorded
+// for the bodies that were not posted.
+func (client *Client) CommentBatch(issue string, bodies []string) (
+	[]error, error) {
+	return client.CommentBatchContext(context.Background(), issue, bodies)
+}
+
+func (client *Client) CommentBatchContext(ctx context.Context, issue string,
+	bodies []string) ([]error, error) {
+	errs := make([]error, len(bodies))
+	abort := func(from int, err error) ([]error, error) {
+		for j := from; j < len(errs); j++ {
+			errs[j] = err
+		}
+		return errs, err
+	}
+
+	for i, msg := range bodies {
+		if err := ctx.Err(); err != nil {
+			return abort(i, err)
+		}
+
+		err := client.CommentContext(ctx, issue, msg)
+		errs[i] = err
+
+		if jiraErr, ok := err.(Error); ok && jiraErr.StatusCode == 401 {
+			return abort(i+1, err)
+		}
+	}
+
+	return errs, nil
+}
@@ -0,0 +1,33 @@
+package jira
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCommentRoundTrip(t *testing.T) {
+	for _, data := range []string{
+		`{"id":"1","body":"plain text"}`,
+		`{"id":"2","body":{"type":"doc","version":1,"content":[]}}`,
+	} {
+		var comment Comment
+		if err := json.Unmarshal([]byte(data), &comment); err != nil {
+			t.Fatal(err)
+		}
+
+		encoded, err := json.Marshal(comment)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var decoded Comment
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if decoded.Body != comment.Body ||
+			string(decoded.ADFBody) != string(comment.ADFBody) {
+			t.Errorf("%s: got %+v after a round trip, want %+v", data,
+				decoded, comment)
+		}
+	}
+}
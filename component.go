@@ -0,0 +1,71 @@
+package jira
+
+import (
+	"encoding/json"
+)
+
+// Component is a project component. AssigneeType says who issues of the
+// component are assigned to by default, e.g. "PROJECT_LEAD".
+type Component struct {
+	Id           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Lead         User   `json:"lead"`
+	AssigneeType string `json:"assigneeType"`
+}
+
+// GetProjectComponents returns the components of the project projectKey.
+func (client *Client) GetProjectComponents(projectKey string) (
+	[]Component, error) {
+	response, err := client.Request("GET",
+		escapePath("project", projectKey, "components"), []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var components []Component
+	if err := json.Unmarshal(response, &components); err != nil {
+		return nil, err
+	}
+
+	return components, nil
+}
+
+// CreateComponent creates a component named name in the project
+// projectKey, led by the user with the given account id (username on Jira
+// Server), if not empty.
+func (client *Client) CreateComponent(projectKey string, name string,
+	leadAccountId string) (*Component, error) {
+	payload := map[string]interface{}{
+		"project": projectKey,
+		"name":    name,
+	}
+	if leadAccountId != "" {
+		cloud, err := client.isCloud()
+		if err != nil {
+			return nil, err
+		}
+		if cloud {
+			payload["leadAccountId"] = leadAccountId
+		} else {
+			payload["leadUserName"] = leadAccountId
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.Request("POST", "component", body)
+	if err != nil {
+		return nil, err
+	}
+
+	component := &Component{}
+	if err := json.Unmarshal(response, component); err != nil {
+		return nil, err
+	}
+
+	return component, nil
+}
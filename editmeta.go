@@ -0,0 +1,37 @@
+package jira
+
+import (
+	"encoding/json"
+)
+
+// FieldMeta describes how a field can be set on a screen. AllowedValues,
+// if any, holds the valid values as returned by Jira, typically objects
+// with an "id" and a "name" or "value". Operations lists the update verbs
+// the field supports, e.g. "set", "add" or "remove".
+type FieldMeta struct {
+	Name            string        `json:"name"`
+	Required        bool          `json:"required"`
+	HasDefaultValue bool          `json:"hasDefaultValue"`
+	Schema          FieldSchema   `json:"schema"`
+	AllowedValues   []interface{} `json:"allowedValues,omitempty"`
+	Operations      []string      `json:"operations"`
+}
+
+// GetEditMeta returns, by field id, the fields on the issue's edit screen,
+// i.e. the ones UpdateIssue can set.
+func (client *Client) GetEditMeta(key string) (map[string]FieldMeta, error) {
+	response, err := client.Request("GET", escapePath("issue", key, "editmeta"),
+		[]byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var rawData struct {
+		Fields map[string]FieldMeta `json:"fields"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return nil, err
+	}
+
+	return rawData.Fields, nil
+}
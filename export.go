@@ -0,0 +1,122 @@
+package jira
+
+import (
+	"sync"
+)
+
+// exportPageSize is the page size ExportIssue fetches comments and the
+// changelog with.
+const exportPageSize = 100
+
+// IssueExport is a self-contained snapshot of an issue, as returned by
+// ExportIssue, that can be serialized as JSON.
+type IssueExport struct {
+	Id  string `json:"id"`
+	Key string `json:"key"`
+
+	// Fields holds the values of all fields of the issue by field id, and
+	// FieldNames their display names.
+	Fields     map[string]interface{} `json:"fields"`
+	FieldNames map[string]string      `json:"fieldNames"`
+
+	Comments    []Comment        `json:"comments"`
+	Attachments []Attachment     `json:"attachments"`
+	Changelog   []ChangelogEntry `json:"changelog"`
+}
+
+// ExportIssue fetches the issue with all its fields, comments, attachment
+// metadata and change history, e.g. to migrate it to another instance. The
+// attachment contents are not downloaded; see DownloadAttachments. The
+// issue, its comments and its changelog are fetched concurrently.
+func (client *Client) ExportIssue(key string) (*IssueExport, error) {
+	var wg sync.WaitGroup
+	var issue *Issue
+	var comments []Comment
+	var changelog []ChangelogEntry
+	var issueErr, commentsErr, changelogErr error
+
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		issue, issueErr = client.GetIssueExpanded(key, []string{"*all"},
+			[]string{"names"})
+	}()
+	go func() {
+		defer wg.Done()
+		comments, commentsErr = client.allComments(key)
+	}()
+	go func() {
+		defer wg.Done()
+		changelog, changelogErr = client.allChangelog(key)
+	}()
+	wg.Wait()
+
+	for _, err := range []error{issueErr, commentsErr, changelogErr} {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var rawData struct {
+		Attachment []Attachment `json:"attachment"`
+	}
+	if err := issue.Unmarshal(&rawData); err != nil {
+		return nil, err
+	}
+
+	return &IssueExport{
+		Id:          issue.Id,
+		Key:         issue.Key,
+		Fields:      issue.Data,
+		FieldNames:  issue.FieldNames,
+		Comments:    comments,
+		Attachments: rawData.Attachment,
+		Changelog:   changelog,
+	}, nil
+}
+
+func (client *Client) allComments(key string) ([]Comment, error) {
+	comments := []Comment{}
+	for {
+		page, total, err := client.GetComments(key, len(comments),
+			exportPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		comments = append(comments, page...)
+		if len(page) == 0 || len(comments) >= total {
+			return comments, nil
+		}
+	}
+}
+
+func (client *Client) allChangelog(key string) ([]ChangelogEntry, error) {
+	cloud, err := client.isCloud()
+	if err != nil {
+		return nil, err
+	}
+	if !cloud {
+		// Jira Server returns the whole history with every request, so
+		// paging through it would fetch it over and over.
+		changelog, _, err := client.getExpandedChangelog(key, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		return append([]ChangelogEntry{}, changelog...), nil
+	}
+
+	changelog := []ChangelogEntry{}
+	for {
+		page, total, err := client.GetIssueChangelog(key, len(changelog),
+			exportPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		changelog = append(changelog, page...)
+		if len(page) == 0 || len(changelog) >= total {
+			return changelog, nil
+		}
+	}
+}
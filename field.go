@@ -0,0 +1,132 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// defaultFieldCacheTTL is how long the cached field list is used before it
+// is fetched again, unless changed with SetFieldCacheTTL.
+const defaultFieldCacheTTL = time.Hour
+
+// Field describes a system or custom issue field. Custom fields have ids
+// like "customfield_10011".
+type Field struct {
+	Id     string      `json:"id"`
+	Name   string      `json:"name"`
+	Custom bool        `json:"custom"`
+	Schema FieldSchema `json:"schema"`
+}
+
+// FieldSchema describes the type of the values of a field. Items is the type
+// of the elements when Type is "array"; Custom is the custom field type key.
+type FieldSchema struct {
+	Type     string `json:"type"`
+	Items    string `json:"items,omitempty"`
+	System   string `json:"system,omitempty"`
+	Custom   string `json:"custom,omitempty"`
+	CustomId int    `json:"customId,omitempty"`
+}
+
+// GetFields returns all issue fields, system and custom.
+func (client *Client) GetFields() ([]Field, error) {
+	response, err := client.Request("GET", "field", []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []Field
+	if err := json.Unmarshal(response, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// FieldIdByName returns the id of the field named name, e.g.
+// "customfield_10011" for "Epic Name". A field id is returned as is. The
+// field list is cached; see SetFieldCacheTTL and RefreshFieldCache.
+func (client *Client) FieldIdByName(name string) (string, error) {
+	fields, err := client.cachedFields()
+	if err != nil {
+		return "", err
+	}
+
+	id := ""
+	for _, field := range fields {
+		if field.Id == name {
+			return field.Id, nil
+		}
+		if field.Name != name {
+			continue
+		}
+		if id != "" {
+			return "", fmt.Errorf("ambiguous field name %q: %s and %s", name,
+				id, field.Id)
+		}
+		id = field.Id
+	}
+
+	if id == "" {
+		return "", fmt.Errorf("unknown field %q", name)
+	}
+
+	return id, nil
+}
+
+// InvalidateFieldCache drops the cached field list so the next lookup
+// fetches it again, e.g. after a custom field was added.
+func (client *Client) InvalidateFieldCache() {
+	client.fieldsMu.Lock()
+	defer client.fieldsMu.Unlock()
+
+	client.fields = nil
+}
+
+// SetFieldCacheTTL sets how long the cached field list is used before it is
+// fetched again, one hour by default, so fields added in Jira become known
+// to a long-running process. Zero or less keeps it until it is invalidated
+// or refreshed.
+func (client *Client) SetFieldCacheTTL(ttl time.Duration) {
+	client.fieldsMu.Lock()
+	defer client.fieldsMu.Unlock()
+
+	client.fieldsTTL = ttl
+}
+
+// RefreshFieldCache fetches the field list right away and replaces the
+// cached one with it. If the fetch fails, the cached list is kept.
+func (client *Client) RefreshFieldCache() error {
+	client.fieldsMu.Lock()
+	defer client.fieldsMu.Unlock()
+
+	return client.fetchFields()
+}
+
+func (client *Client) cachedFields() ([]Field, error) {
+	client.fieldsMu.Lock()
+	defer client.fieldsMu.Unlock()
+
+	expired := client.fieldsTTL > 0 &&
+		time.Since(client.fieldsFetched) > client.fieldsTTL
+	if client.fields == nil || expired {
+		if err := client.fetchFields(); err != nil {
+			return nil, err
+		}
+	}
+
+	return client.fields, nil
+}
+
+// fetchFields fills the field cache. The caller must hold fieldsMu.
+func (client *Client) fetchFields() error {
+	fields, err := client.GetFields()
+	if err != nil {
+		return err
+	}
+	client.fields = fields
+	client.fieldsFetched = time.Now()
+
+	return nil
+}
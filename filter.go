@@ -0,0 +1,41 @@
+package jira
+
+import (
+	"encoding/json"
+)
+
+// Filter is a saved JQL search.
+type Filter struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Jql   string `json:"jql"`
+	Owner User   `json:"owner"`
+}
+
+// GetFilter returns the saved filter with the given id.
+func (client *Client) GetFilter(filterId string) (*Filter, error) {
+	response, err := client.Request("GET", escapePath("filter", filterId),
+		[]byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &Filter{}
+	if err := json.Unmarshal(response, filter); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+// SearchByFilter runs the JQL of the saved filter with the given id, like
+// Search.
+func (client *Client) SearchByFilter(filterId string, fields []string,
+	startAt int, maxResults int) ([]*Issue, int, error) {
+	filter, err := client.GetFilter(filterId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return client.Search(filter.Jql, fields, startAt, maxResults)
+}
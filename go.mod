@@ -0,0 +1,3 @@
+module github.com/joprice/go-jira
+
+go 1.20
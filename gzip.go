@@ -0,0 +1,40 @@
+package jira
+
+import (
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// responseBody returns the body of resp, transparently decompressing it if
+// the server gzipped it. Go's transport only does this by itself when it
+// added the Accept-Encoding header itself, which it doesn't once the header
+// is set explicitly. Closing the returned body closes resp.Body.
+func responseBody(resp *http.Response) (io.ReadCloser, error) {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return resp.Body, nil
+	}
+
+	reader, err := gzip.NewReader(resp.Body)
+	if err == io.EOF {
+		// An empty body, e.g. of a 204 No Content response.
+		return ioutil.NopCloser(strings.NewReader("")), resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &gzipBody{Reader: reader, body: resp.Body}, nil
+}
+
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (body *gzipBody) Close() error {
+	body.Reader.Close()
+	return body.body.Close()
+}
@@ -0,0 +1,451 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrHasSubtasks is returned by DeleteIssue when the issue has subtasks but
+// their deletion was not requested.
+var ErrHasSubtasks = errors.New("issue has subtasks")
+
+// AssigneeAutomatic can be passed to AssignIssue, or set as the "assignee"
+// field for CreateIssue, to have Jira pick the assignee according to the
+// project's default assignee setting.
+const AssigneeAutomatic = "-1"
+
+// ErrNoAutomaticAssignee is returned by AssignIssue and CreateIssue when
+// AssigneeAutomatic was requested but Jira could not determine an assignee,
+// e.g. because the project has no default assignee.
+var ErrNoAutomaticAssignee = errors.New("no automatic assignee available")
+
+// FieldNotOnScreenError is returned by CreateIssue and UpdateIssue when a
+// field can't be set because it is not on the create or edit screen of the
+// issue (or doesn't exist). Adding the field to the screen fixes this. Err
+// is the Error Jira answered with.
+type FieldNotOnScreenError struct {
+	FieldId string
+	Err     error
+}
+
+func (e *FieldNotOnScreenError) Error() string {
+	return "field " + e.FieldId + " is not on the screen or unknown: " +
+		e.Err.Error()
+}
+
+func (e *FieldNotOnScreenError) Unwrap() error {
+	return e.Err
+}
+
+// CreateIssue creates an issue of the given type in project. The project
+// key, issue type name and summary are merged into fields, which may hold any
+// other (including custom) fields to set. Setting "assignee" to
+// AssigneeAutomatic assigns the issue to the project's default assignee.
+// Validation errors reported by Jira are returned as an Error.
+func (client *Client) CreateIssue(project string, issuetype string,
+	summary string, fields map[string]interface{}) (*Issue, error) {
+	return client.CreateIssueContext(context.Background(), project, issuetype,
+		summary, fields)
+}
+
+func (client *Client) CreateIssueContext(ctx context.Context,
+	project string, issuetype string, summary string,
+	fields map[string]interface{}) (*Issue, error) {
+	data := map[string]interface{}{}
+	for name, value := range fields {
+		data[name] = value
+	}
+	data["project"] = map[string]string{"key": project}
+	data["issuetype"] = map[string]string{"name": issuetype}
+	data["summary"] = summary
+
+	automatic := data["assignee"] == AssigneeAutomatic
+	if automatic {
+		assignee, err := client.assigneePayload(AssigneeAutomatic)
+		if err != nil {
+			return nil, err
+		}
+		data["assignee"] = assignee
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"fields": data})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.RequestWithContext(ctx, "POST", "issue", body)
+	if jiraErr, ok := err.(Error); ok && jiraErr.StatusCode == 400 &&
+		automatic && jiraErr.FieldErrors["assignee"] != "" {
+		return nil, fmt.Errorf("%s: %w: %w", project,
+			ErrNoAutomaticAssignee, err)
+	}
+	if err != nil {
+		return nil, fieldNotOnScreen(err)
+	}
+
+	var created struct {
+		Id  string `json:"id"`
+		Key string `json:"key"`
+	}
+	if err := json.Unmarshal(response, &created); err != nil {
+		return nil, err
+	}
+
+	return &Issue{
+		Id:      created.Id,
+		Key:     created.Key,
+		Summary: summary,
+		Project: strings.ToLower(project),
+		Data:    data,
+	}, nil
+}
+
+// UpdateIssue sets fields on an existing issue. Jira answers with 204 No
+// Content on success; a field that is not on the edit screen is reported as
+// a *FieldNotOnScreenError, other problems as an Error carrying Jira's
+// message.
+func (client *Client) UpdateIssue(key string,
+	fields map[string]interface{}) error {
+	return client.UpdateIssueContext(context.Background(), key, fields)
+}
+
+func (client *Client) UpdateIssueContext(ctx context.Context, key string,
+	fields map[string]interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{"fields": fields})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.RequestWithContext(ctx, "PUT", escapePath("issue", key),
+		body)
+
+	return fieldNotOnScreen(err)
+}
+
+// fieldNotOnScreen turns a 400 Error that reports a field as not on the
+// screen into a *FieldNotOnScreenError, and returns other errors as is.
+func fieldNotOnScreen(err error) error {
+	jiraErr, ok := err.(Error)
+	if !ok || jiraErr.StatusCode != 400 {
+		return err
+	}
+
+	fieldIds := make([]string, 0, len(jiraErr.FieldErrors))
+	for fieldId := range jiraErr.FieldErrors {
+		fieldIds = append(fieldIds, fieldId)
+	}
+	sort.Strings(fieldIds)
+
+	for _, fieldId := range fieldIds {
+		if strings.Contains(jiraErr.FieldErrors[fieldId],
+			"not on the appropriate screen") {
+			return &FieldNotOnScreenError{FieldId: fieldId, Err: err}
+		}
+	}
+
+	return err
+}
+
+// AssignIssue assigns the issue to the user with the given account id on
+// Jira Cloud, or username on Jira Server. An empty accountId unassigns the
+// issue; AssigneeAutomatic assigns it to the project's default assignee.
+func (client *Client) AssignIssue(key string, accountId string) error {
+	return client.AssignIssueContext(context.Background(), key, accountId)
+}
+
+func (client *Client) AssignIssueContext(ctx context.Context, key string,
+	accountId string) error {
+	assignee, err := client.assigneePayload(accountId)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(assignee)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.RequestWithContext(ctx, "PUT",
+		escapePath("issue", key, "assignee"), body)
+	if jiraErr, ok := err.(Error); ok && jiraErr.StatusCode == 400 {
+		if accountId == AssigneeAutomatic {
+			return fmt.Errorf("%s: %w: %w", key, ErrNoAutomaticAssignee,
+				err)
+		}
+		return fmt.Errorf("user %q cannot be assigned to %s: %w", accountId,
+			key, err)
+	}
+
+	return err
+}
+
+// assigneePayload returns the user object referring to the user with the
+// given account id, or username on Jira Server. An empty accountId results
+// in null.
+func (client *Client) assigneePayload(accountId string) (
+	map[string]interface{}, error) {
+	var assignee interface{}
+	if accountId != "" {
+		assignee = accountId
+	}
+
+	cloud, err := client.isCloud()
+	if err != nil {
+		return nil, err
+	}
+
+	field := "name"
+	if cloud {
+		field = "accountId"
+	}
+
+	return map[string]interface{}{field: assignee}, nil
+}
+
+// DeleteIssue deletes the issue. If the issue has subtasks they are deleted
+// along with it when deleteSubtasks is true; otherwise ErrHasSubtasks is
+// returned and nothing is deleted.
+func (client *Client) DeleteIssue(key string, deleteSubtasks bool) error {
+	return client.DeleteIssueContext(context.Background(), key, deleteSubtasks)
+}
+
+func (client *Client) DeleteIssueContext(ctx context.Context, key string,
+	deleteSubtasks bool) error {
+	_, err := client.RequestWithContext(ctx, "DELETE",
+		escapePath("issue", key)+"?deleteSubtasks="+
+			strconv.FormatBool(deleteSubtasks), []byte{})
+	if jiraErr, ok := err.(Error); ok && jiraErr.StatusCode == 400 &&
+		!deleteSubtasks {
+		return fmt.Errorf("%s: %w: %w", key, ErrHasSubtasks, err)
+	}
+
+	return err
+}
+
+// StatusName returns the name of the issue's status, if the status field was
+// fetched.
+func (issue *Issue) StatusName() (string, bool) {
+	return issue.objectString("status", "name")
+}
+
+// AssigneeDisplayName returns the display name of the issue's assignee. It
+// reports false if the issue is unassigned or the field was not fetched.
+func (issue *Issue) AssigneeDisplayName() (string, bool) {
+	return issue.objectString("assignee", "displayName")
+}
+
+// Priority returns the name of the issue's priority, if set and fetched.
+func (issue *Issue) Priority() (string, bool) {
+	return issue.objectString("priority", "name")
+}
+
+// Labels returns the issue's labels, or nil if the field was not fetched.
+func (issue *Issue) Labels() []string {
+	rawLabels, ok := issue.Data["labels"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	labels := make([]string, 0, len(rawLabels))
+	for _, rawLabel := range rawLabels {
+		if label, ok := rawLabel.(string); ok {
+			labels = append(labels, label)
+		}
+	}
+
+	return labels
+}
+
+// Description returns the issue's plain text description. It reports false
+// if the description is empty (null), was not fetched or is not plain text.
+func (issue *Issue) Description() (string, bool) {
+	description, ok := issue.Data["description"].(string)
+	return description, ok
+}
+
+// objectString returns the string value under key of the object stored in
+// the field named field.
+func (issue *Issue) objectString(field string, key string) (string, bool) {
+	object, ok := issue.Data[field].(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+
+	value, ok := object[key].(string)
+	return value, ok
+}
+
+// CreateSubtask creates a subtask of the issue parentKey in the parent's
+// project. issuetype must name a subtask issue type of that project, which
+// is checked up front since Jira's own error for this is confusing.
+func (client *Client) CreateSubtask(parentKey string, summary string,
+	issuetype string, fields map[string]interface{}) (*Issue, error) {
+	parent, err := client.GetIssue(parentKey, []string{"project"})
+	if err != nil {
+		return nil, err
+	}
+
+	project, ok := parent.objectString("project", "key")
+	if !ok {
+		return nil, fmt.Errorf("unexpected response: missing project field")
+	}
+
+	issueTypes, err := client.GetCreateMeta(project)
+	if err != nil {
+		return nil, err
+	}
+
+	subtask := false
+	for _, issueType := range issueTypes {
+		if strings.EqualFold(issueType.Name, issuetype) {
+			if !issueType.Subtask {
+				return nil, fmt.Errorf("issue type %q is not a subtask type",
+					issuetype)
+			}
+			subtask = true
+			break
+		}
+	}
+	if !subtask {
+		return nil, fmt.Errorf("unknown issue type %q in project %s",
+			issuetype, project)
+	}
+
+	data := map[string]interface{}{}
+	for name, value := range fields {
+		data[name] = value
+	}
+	data["parent"] = map[string]string{"key": parentKey}
+
+	return client.CreateIssue(project, issuetype, summary, data)
+}
+
+// Unmarshal decodes the issue's fields into v, typically a struct whose json
+// tags name the fields, including custom ones like "customfield_10011".
+func (issue *Issue) Unmarshal(v interface{}) error {
+	data, err := json.Marshal(issue.Data)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// GetIssueInto fetches the issue like GetIssue but decodes its fields
+// directly into v, like Issue.Unmarshal, without building an Issue.
+func (client *Client) GetIssueInto(key string, fields []string,
+	v interface{}) error {
+	response, err := client.Request("GET", issuePath(key, fields, nil),
+		[]byte{})
+	if err != nil {
+		return err
+	}
+
+	var rawData struct {
+		Fields json.RawMessage `json:"fields"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return err
+	}
+	if rawData.Fields == nil {
+		return fmt.Errorf("unexpected response: missing fields field")
+	}
+
+	return json.Unmarshal(rawData.Fields, v)
+}
+
+// ClearFields removes the values of the given fields of the issue, e.g. its
+// due date or a custom field, by setting them to null. The fields are
+// checked against the issue's edit screen first: fields that are not on it
+// or are required can't be cleared.
+func (client *Client) ClearFields(key string, fieldNames ...string) error {
+	meta, err := client.GetEditMeta(key)
+	if err != nil {
+		return err
+	}
+
+	fields := make(map[string]interface{}, len(fieldNames))
+	for _, name := range fieldNames {
+		field, ok := meta[name]
+		if !ok {
+			return fmt.Errorf("field %s of %s is not on the edit screen",
+				name, key)
+		}
+		if field.Required {
+			return fmt.Errorf("field %s (%s) of %s is required and cannot "+
+				"be cleared", name, field.Name, key)
+		}
+		fields[name] = nil
+	}
+
+	return client.UpdateIssue(key, fields)
+}
+
+// SetDueDate sets the due date of the issue to the date of due, in due's
+// location; the time of day is dropped, as Jira only accepts dates. The
+// zero Time clears the due date.
+func (client *Client) SetDueDate(key string, due time.Time) error {
+	var value interface{}
+	if !due.IsZero() {
+		value = due.Format(dateLayout)
+	}
+
+	return client.UpdateIssue(key, map[string]interface{}{"duedate": value})
+}
+
+// Created returns when the issue was created. Like the other date
+// accessors, it returns the zero Time if the field is empty or was not
+// fetched, and an error if it can't be parsed.
+func (issue *Issue) Created() (time.Time, error) {
+	return issue.timeField("created")
+}
+
+// Updated returns when the issue was last updated.
+func (issue *Issue) Updated() (time.Time, error) {
+	return issue.timeField("updated")
+}
+
+// ResolutionDate returns when the issue was resolved.
+func (issue *Issue) ResolutionDate() (time.Time, error) {
+	return issue.timeField("resolutiondate")
+}
+
+// DueDate returns the due date of the issue, at midnight UTC.
+func (issue *Issue) DueDate() (time.Time, error) {
+	value, ok := issue.Data["duedate"].(string)
+	if !ok || value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(dateLayout, value)
+}
+
+func (issue *Issue) timeField(field string) (time.Time, error) {
+	value, ok := issue.Data[field].(string)
+	if !ok || value == "" {
+		return time.Time{}, nil
+	}
+
+	return parseTime(value)
+}
+
+// parseTime parses a Jira datetime such as "2023-04-01T10:00:00.000+0000",
+// whose zone offset lacks the colon RFC 3339 requires. The fractional
+// seconds are optional, and RFC 3339 values are accepted as well.
+func parseTime(value string) (time.Time, error) {
+	t, err := time.Parse("2006-01-02T15:04:05-0700", value)
+	if err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	return time.Time{}, err
+}
@@ -0,0 +1,55 @@
+package jira
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+type IssueType struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Subtask     bool   `json:"subtask"`
+	Description string `json:"description"`
+}
+
+// GetIssueTypes returns all issue types visible to the user.
+func (client *Client) GetIssueTypes() ([]IssueType, error) {
+	response, err := client.Request("GET", "issuetype", []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var issueTypes []IssueType
+	if err := json.Unmarshal(response, &issueTypes); err != nil {
+		return nil, err
+	}
+
+	return issueTypes, nil
+}
+
+// GetCreateMeta returns the issue types the user can create in the project
+// projectKey.
+func (client *Client) GetCreateMeta(projectKey string) ([]IssueType, error) {
+	query := url.Values{"projectKeys": {projectKey}}
+	response, err := client.Request("GET",
+		"issue/createmeta?"+query.Encode(), []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var rawData struct {
+		Projects []struct {
+			IssueTypes []IssueType `json:"issuetypes"`
+		} `json:"projects"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return nil, err
+	}
+
+	var issueTypes []IssueType
+	for _, project := range rawData.Projects {
+		issueTypes = append(issueTypes, project.IssueTypes...)
+	}
+
+	return issueTypes, nil
+}
@@ -0,0 +1,178 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// SortOrder is the direction of a JQL ORDER BY clause.
+type SortOrder string
+
+const (
+	Asc  SortOrder = "ASC"
+	Desc SortOrder = "DESC"
+)
+
+// JQL builds a JQL query, quoting and escaping values, and field names as
+// needed, so that user input can't change the structure of the query:
+//
+//	jql, err := jira.NewJQL().Project("PROJ").And().Status("In Progress").
+//		OrderBy("created", jira.Desc).Build()
+//
+// Clauses must be joined with And or Or. Mistakes such as a missing or
+// dangling connective are reported by Build.
+type JQL struct {
+	tokens  []string
+	orderBy []string
+	err     error
+}
+
+var (
+	jqlOperators = map[string]bool{
+		"=": true, "!=": true, ">": true, ">=": true, "<": true, "<=": true,
+		"~": true, "!~": true,
+	}
+	jqlReserved = map[string]bool{
+		"and": true, "or": true, "not": true, "empty": true, "null": true,
+		"order": true, "by": true, "in": true, "is": true, "was": true,
+		"changed": true, "asc": true, "desc": true,
+	}
+	jqlBareField = regexp.MustCompile(`^([A-Za-z0-9_.]+|cf\[[0-9]+\])$`)
+)
+
+func NewJQL() *JQL {
+	return &JQL{}
+}
+
+// Field adds the clause `field operator value`, where operator is one of
+// =, !=, >, >=, <, <=, ~ and !~.
+func (q *JQL) Field(field string, operator string, value string) *JQL {
+	if !jqlOperators[operator] {
+		q.fail(fmt.Errorf("invalid JQL operator %q", operator))
+		return q
+	}
+
+	return q.clause(quoteJQLField(field) + " " + operator + " " +
+		quoteJQL(value))
+}
+
+// In adds the clause `field in (values...)`.
+func (q *JQL) In(field string, values ...string) *JQL {
+	if len(values) == 0 {
+		q.fail(fmt.Errorf("JQL in clause on %q without values", field))
+		return q
+	}
+
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, quoteJQL(value))
+	}
+
+	return q.clause(quoteJQLField(field) + " in (" +
+		strings.Join(quoted, ", ") + ")")
+}
+
+// Project adds the clause `project = key`.
+func (q *JQL) Project(key string) *JQL {
+	return q.Field("project", "=", key)
+}
+
+// Status adds the clause `status = name`.
+func (q *JQL) Status(name string) *JQL {
+	return q.Field("status", "=", name)
+}
+
+// Assignee adds the clause `assignee = user`.
+func (q *JQL) Assignee(user string) *JQL {
+	return q.Field("assignee", "=", user)
+}
+
+func (q *JQL) And() *JQL {
+	return q.connective("AND")
+}
+
+func (q *JQL) Or() *JQL {
+	return q.connective("OR")
+}
+
+// OrderBy sorts the results by field. It may be called several times to
+// sort by several fields.
+func (q *JQL) OrderBy(field string, order SortOrder) *JQL {
+	if order != Asc && order != Desc {
+		q.fail(fmt.Errorf("invalid JQL sort order %q", order))
+		return q
+	}
+
+	q.orderBy = append(q.orderBy, quoteJQLField(field)+" "+string(order))
+
+	return q
+}
+
+// Build returns the query, or the first mistake made while building it.
+func (q *JQL) Build() (string, error) {
+	if q.err != nil {
+		return "", q.err
+	}
+	if len(q.tokens)%2 == 0 && len(q.tokens) > 0 {
+		return "", fmt.Errorf("JQL query ends with %s",
+			q.tokens[len(q.tokens)-1])
+	}
+
+	jql := strings.Join(q.tokens, " ")
+	if len(q.orderBy) > 0 {
+		if jql != "" {
+			jql += " "
+		}
+		jql += "ORDER BY " + strings.Join(q.orderBy, ", ")
+	}
+
+	return jql, nil
+}
+
+// clause appends a clause, which must follow a connective unless it is the
+// first one. Clauses and connectives thus alternate in q.tokens.
+func (q *JQL) clause(clause string) *JQL {
+	if len(q.tokens)%2 == 1 {
+		q.fail(fmt.Errorf("JQL clause %q must be joined with And or Or",
+			clause))
+		return q
+	}
+
+	q.tokens = append(q.tokens, clause)
+
+	return q
+}
+
+func (q *JQL) connective(connective string) *JQL {
+	if len(q.tokens)%2 == 0 {
+		q.fail(fmt.Errorf("JQL %s must follow a clause", connective))
+		return q
+	}
+
+	q.tokens = append(q.tokens, connective)
+
+	return q
+}
+
+func (q *JQL) fail(err error) {
+	if q.err == nil {
+		q.err = err
+	}
+}
+
+func quoteJQLField(field string) string {
+	if jqlBareField.MatchString(field) && !jqlReserved[strings.ToLower(field)] {
+		return field
+	}
+
+	return quoteJQL(field)
+}
+
+// quoteJQL returns s as a double-quoted JQL string literal.
+func quoteJQL(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+
+	return `"` + s + `"`
+}
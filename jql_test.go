@@ -0,0 +1,20 @@
+package jira
+
+import (
+	"testing"
+)
+
+func TestJQLQuotesValues(t *testing.T) {
+	got, err := NewJQL().Status("Delete").And().
+		Field("fixVersion", "=", "select").And().
+		In("labels", "a.b", `say "hi"`).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `status = "Delete" AND fixVersion = "select" AND ` +
+		`labels in ("a.b", "say \"hi\"")`
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
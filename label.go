@@ -0,0 +1,82 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// AddLabels adds labels to the issue, leaving its other labels untouched.
+func (client *Client) AddLabels(key string, labels ...string) error {
+	return client.ModifyLabels(key, labels, nil)
+}
+
+// RemoveLabels removes labels from the issue, leaving its other labels
+// untouched.
+func (client *Client) RemoveLabels(key string, labels ...string) error {
+	return client.ModifyLabels(key, nil, labels)
+}
+
+// ModifyLabels adds the labels add to the issue and removes the labels
+// remove from it in a single request, leaving its other labels untouched.
+// A label may not be both added and removed.
+//
+// The labels are changed using Jira's update syntax, so that concurrent
+// label edits don't overwrite each other the way setting the whole labels
+// field would.
+func (client *Client) ModifyLabels(key string, add []string,
+	remove []string) error {
+	return client.ModifyLabelsContext(context.Background(), key, add, remove)
+}
+
+func (client *Client) ModifyLabelsContext(ctx context.Context, key string,
+	add []string, remove []string) error {
+	removed := make(map[string]bool, len(remove))
+	for _, label := range remove {
+		removed[label] = true
+	}
+
+	operations := make([]map[string]string, 0, len(add)+len(remove))
+	for _, label := range add {
+		if removed[label] {
+			return fmt.Errorf("label %q is both added and removed", label)
+		}
+		if err := validateLabel(label); err != nil {
+			return err
+		}
+		operations = append(operations, map[string]string{"add": label})
+	}
+	for _, label := range remove {
+		if err := validateLabel(label); err != nil {
+			return err
+		}
+		operations = append(operations, map[string]string{"remove": label})
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"update": map[string]interface{}{"labels": operations},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.RequestWithContext(ctx, "PUT", escapePath("issue", key),
+		body)
+
+	return err
+}
+
+// validateLabel rejects labels Jira would refuse: empty ones and ones
+// containing whitespace.
+func validateLabel(label string) error {
+	if label == "" {
+		return fmt.Errorf("invalid label: empty")
+	}
+	if strings.IndexFunc(label, unicode.IsSpace) >= 0 {
+		return fmt.Errorf("invalid label %q: contains whitespace", label)
+	}
+
+	return nil
+}
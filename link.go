@@ -0,0 +1,84 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// LinkType is a kind of link between issues, e.g. "Blocks", described from
+// both ends by Inward ("is blocked by") and Outward ("blocks").
+type LinkType struct {
+	Id      string `json:"id"`
+	Name    string `json:"name"`
+	Inward  string `json:"inward"`
+	Outward string `json:"outward"`
+}
+
+// GetIssueLinkTypes returns the issue link types configured in Jira.
+func (client *Client) GetIssueLinkTypes() ([]LinkType, error) {
+	response, err := client.Request("GET", "issueLinkType", []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var rawData struct {
+		IssueLinkTypes []LinkType `json:"issueLinkTypes"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return nil, err
+	}
+
+	return rawData.IssueLinkTypes, nil
+}
+
+// LinkIssues links two issues with the link type named linkType, e.g.
+// "Blocks" or "Relates".
+func (client *Client) LinkIssues(inwardKey string, outwardKey string,
+	linkType string) error {
+	type issueRef struct {
+		Key string `json:"key"`
+	}
+	type typeRef struct {
+		Name string `json:"name"`
+	}
+	type issueLink struct {
+		Type         typeRef  `json:"type"`
+		InwardIssue  issueRef `json:"inwardIssue"`
+		OutwardIssue issueRef `json:"outwardIssue"`
+	}
+
+	body, err := json.Marshal(issueLink{
+		Type:         typeRef{Name: linkType},
+		InwardIssue:  issueRef{Key: inwardKey},
+		OutwardIssue: issueRef{Key: outwardKey},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Request("POST", "issueLink", body)
+	if jiraErr, ok := err.(Error); ok && jiraErr.StatusCode == 400 {
+		if known, lookupErr := client.isLinkType(linkType); lookupErr == nil &&
+			!known {
+			return fmt.Errorf("unknown issue link type %q", linkType)
+		}
+	}
+
+	return err
+}
+
+func (client *Client) isLinkType(name string) (bool, error) {
+	linkTypes, err := client.GetIssueLinkTypes()
+	if err != nil {
+		return false, err
+	}
+
+	for _, linkType := range linkTypes {
+		if strings.EqualFold(linkType.Name, name) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
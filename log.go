@@ -0,0 +1,17 @@
+package jira
+
+import (
+	"time"
+)
+
+// Logger is called after every request with its method, URL, response
+// status (zero if no response was received) and duration. It never sees
+// request headers or bodies, so credentials can't leak into logs.
+type Logger func(method string, url string, status int,
+	duration time.Duration)
+
+// SetLogger installs logger to be called after every request. A nil logger,
+// the default, disables logging.
+func (client *Client) SetLogger(logger Logger) {
+	client.logger = logger
+}
@@ -2,24 +2,98 @@ package jira
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"net/url"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 )
 
+// LibraryVersion is the version of this package, reported in the default
+// User-Agent header.
+const LibraryVersion = "0.1.0"
+
+const defaultUserAgent = "go-jira/" + LibraryVersion
+
+const (
+	// timeLayout is the format of Jira's datetime values, which is stricter
+	// than RFC 3339: milliseconds are required and the zone offset has no
+	// colon.
+	timeLayout = "2006-01-02T15:04:05.000-0700"
+
+	// dateLayout is the format of Jira's date-only values.
+	dateLayout = "2006-01-02"
+)
+
+var (
+	// ErrNotFound is returned when the requested item doesn't exist, e.g.
+	// by FindOne when no issue matches. An Error for a 404 response matches
+	// it with errors.Is.
+	ErrNotFound = errors.New("not found")
+
+	// ErrUnauthorized is matched by an Error for a 401 response, i.e. the
+	// credentials were rejected.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrForbidden is matched by an Error for a 403 response, i.e. the user
+	// may not perform the request.
+	ErrForbidden = errors.New("forbidden")
+)
+
 type Error struct {
 	StatusCode int
 	Status     string
 	Message    string
+
+	// ErrorMessages and FieldErrors hold the "errorMessages" and "errors"
+	// (field id to message) parts of Jira's error response, if any.
+	ErrorMessages []string
+	FieldErrors   map[string]string
+
+	// Method and URL identify the request that failed; Body is the raw
+	// response body.
+	Method string
+	URL    string
+	Body   []byte
 }
 
 func (e Error) Error() string {
-	return fmt.Sprintf("%s: %s", e.Status, e.Message)
+	if e.Method == "" {
+		return fmt.Sprintf("%s: %s", e.Status, e.Message)
+	}
+
+	path := e.URL
+	if parsed, err := url.Parse(e.URL); err == nil {
+		path = parsed.Path
+	}
+
+	return fmt.Sprintf("%s %s: %s: %s", e.Method, path, e.Status, e.Message)
+}
+
+// Is reports whether the error matches target, so that errors.Is(err,
+// ErrNotFound) holds for a 404 response, and likewise for ErrUnauthorized
+// (401) and ErrForbidden (403).
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	}
+
+	return false
 }
 
 type Issue struct {
@@ -28,49 +102,305 @@ type Issue struct {
 	Summary string
 	Project string
 	Data    map[string]interface{}
+
+	// FieldNames maps field ids to their display names. It is only set when
+	// the issue was fetched with the "names" expansion.
+	FieldNames map[string]string
 }
 
+type authMode int
+
+const (
+	authBasic authMode = iota
+	authBearer
+)
+
+// Deployment selects between the request shapes of Jira Server (and Data
+// Center), which identifies users by username, and Jira Cloud, which
+// identifies them by account id.
+type Deployment int
+
+const (
+	DeploymentServer Deployment = iota
+	DeploymentCloud
+	// DeploymentAuto detects the deployment from the server info on first
+	// use.
+	DeploymentAuto
+)
+
 type Client struct {
-	baseUrl *url.URL
-	user    string
-	pass    string
-	res     *http.Client
+	baseUrl     *url.URL
+	apiPath     string
+	deployment  Deployment
+	auth        authMode
+	user        string
+	pass        string
+	token       string
+	credentials CredentialProvider
+	userAgent   string
+	closeNames  []string
+	runAs       string
+	runAsHeader string
+	res         *http.Client
+	retry       retryPolicy
+	logger      Logger
+	limiter     *rateLimiter
+
+	// requestTimeout bounds each request as a whole; zero means no limit.
+	requestTimeout time.Duration
+
+	// updateWhereLimit is the most issues UpdateWhere may update; zero or
+	// less means no limit.
+	updateWhereLimit int
+
+	// maxResponseBytes bounds the size of buffered response bodies; zero
+	// or less means no limit.
+	maxResponseBytes int64
+
+	// transport is the transport of res if the client built it itself,
+	// and nil if res was supplied by the caller.
+	transport *http.Transport
+
+	serverInfoMu sync.Mutex
+	serverInfo   *ServerInfo
+
+	fieldsMu      sync.Mutex
+	fields        []Field
+	fieldsFetched time.Time
+	fieldsTTL     time.Duration
+
+	timeZoneMu sync.Mutex
+	timeZone   *time.Location
 }
 
+const (
+	// responseHeaderTimeout bounds the wait for response headers once a
+	// request has been sent, so a server that accepts the connection but
+	// never answers can't hang a request forever.
+	responseHeaderTimeout = 60 * time.Second
+
+	// idleConnTimeout is how long idle keep-alive connections are kept.
+	idleConnTimeout = 90 * time.Second
+)
+
+// NewClient creates a client authenticating with basic auth. timeout bounds
+// establishing connections, including the TLS handshake; dials also honor
+// the deadline of a request's context.
 func NewClient(jiraUrl string, user string, pass string, timeout time.Duration) (
 	*Client, error) {
-	baseUrl, err := url.Parse(jiraUrl)
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           (&net.Dialer{Timeout: timeout}).DialContext,
+		TLSHandshakeTimeout:   timeout,
+		ResponseHeaderTimeout: responseHeaderTimeout,
+		IdleConnTimeout:       idleConnTimeout,
+	}
+
+	client, err := NewWithClient(jiraUrl, user, pass,
+		&http.Client{Transport: transport})
 	if err != nil {
 		return nil, err
 	}
+	client.transport = transport
+
+	return client, nil
+}
 
-	httpClient := &http.Client{Transport: &http.Transport{
-		Dial: func(proto, addr string) (net.Conn, error) {
-			return net.DialTimeout(proto, addr, timeout)
-		},
-	}}
+// NewWithClient creates a client that sends its requests through httpClient,
+// e.g. one configured with a proxy, custom TLS settings or tracing.
+func NewWithClient(jiraUrl string, user string, pass string,
+	httpClient *http.Client) (*Client, error) {
+	baseUrl, apiPath, err := parseBaseUrl(jiraUrl)
+	if err != nil {
+		return nil, err
+	}
 
 	client := &Client{
-		baseUrl: baseUrl,
-		user:    user,
-		pass:    pass,
-		res:     httpClient,
+		baseUrl:    baseUrl,
+		apiPath:    apiPath,
+		user:       user,
+		pass:       pass,
+		userAgent:  defaultUserAgent,
+		closeNames: defaultCloseTransitionNames,
+		res:        httpClient,
+		fieldsTTL:  defaultFieldCacheTTL,
+		deployment: DeploymentAuto,
+
+		maxResponseBytes: defaultMaxResponseBytes,
+		updateWhereLimit: defaultUpdateWhereLimit,
 	}
 
 	return client, nil
 }
 
-func (client *Client) GetIssue(key string, fields []string) (
-	issue *Issue, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = r.(error)
-		}
-	}()
+var restApiPath = regexp.MustCompile(`(^|/)rest/api/[^/]+/?$`)
+
+// parseBaseUrl splits jiraUrl into the root of the Jira instance, including
+// any context path it is served under, and the REST API path below it. The
+// URL may point at either, with or without a trailing slash:
+// "https://host", "https://host/context/" and
+// "https://host/context/rest/api/2" are all accepted. The API path defaults
+// to "rest/api/2/".
+func parseBaseUrl(jiraUrl string) (*url.URL, string, error) {
+	baseUrl, err := url.Parse(jiraUrl)
+	if err != nil {
+		return nil, "", err
+	}
+
+	apiPath := "rest/api/2/"
+	if match := restApiPath.FindString(baseUrl.Path); match != "" {
+		baseUrl.Path = strings.TrimSuffix(baseUrl.Path, match)
+		apiPath = strings.TrimSuffix(strings.TrimPrefix(match, "/"), "/") + "/"
+	}
+	baseUrl.Path = strings.TrimSuffix(baseUrl.Path, "/") + "/"
+	baseUrl.RawPath = ""
+
+	return baseUrl, apiPath, nil
+}
+
+// NewWithToken creates a client that authenticates with an API token or
+// personal access token sent as a Bearer header instead of basic auth.
+func NewWithToken(jiraUrl string, token string, timeout time.Duration) (
+	*Client, error) {
+	client, err := NewClient(jiraUrl, "", "", timeout)
+	if err != nil {
+		return nil, err
+	}
 
-	response, err := client.Request("GET",
-		"issue/"+key+"/?fields="+strings.Join(fields, ","),
-		[]byte{})
+	client.auth = authBearer
+	client.token = token
+
+	return client, nil
+}
+
+// CredentialProvider returns the basic auth credentials to send with a
+// request.
+type CredentialProvider func() (user string, pass string, err error)
+
+// SetCredentialProvider makes the client fetch basic auth credentials from
+// provider for every request, e.g. from a secrets vault, instead of keeping
+// them in memory. Any credentials the client was created with are dropped.
+// An error from provider aborts the request.
+func (client *Client) SetCredentialProvider(provider CredentialProvider) {
+	client.credentials = provider
+	client.user = ""
+	client.pass = ""
+	client.token = ""
+}
+
+// SetAPIVersion selects the version of the REST API requests go to, "2"
+// by default (or the version in the base URL the client was created with).
+// Version "3", available on Jira Cloud, expects and returns rich text such
+// as comment bodies and descriptions in Atlassian Document Format instead
+// of plain text. Methods that take or return plain text comments (Comment,
+// UpdateComment, CommentWithVisibility, TransitionWithComment and the
+// worklog methods) always use version 2; use CommentADF for rich text.
+func (client *Client) SetAPIVersion(version string) {
+	client.apiPath = "rest/api/" + version + "/"
+}
+
+// APIVersion returns the version of the REST API requests go to.
+func (client *Client) APIVersion() string {
+	return strings.TrimSuffix(strings.TrimPrefix(client.apiPath, "rest/api/"),
+		"/")
+}
+
+// SetUserAgent overrides the User-Agent header sent with every request,
+// which defaults to "go-jira/<LibraryVersion>".
+func (client *Client) SetUserAgent(userAgent string) {
+	client.userAgent = userAgent
+}
+
+// SetTLSConfig sets the TLS configuration used to connect to Jira, e.g. to
+// trust an internal certificate authority through RootCAs. Setting
+// InsecureSkipVerify disables certificate verification altogether and
+// should only ever be used for testing. The configuration can't be changed
+// on a client created with NewWithClient; configure the supplied
+// http.Client instead.
+func (client *Client) SetTLSConfig(config *tls.Config) error {
+	if client.transport == nil {
+		return fmt.Errorf("cannot set TLS config on a custom http.Client")
+	}
+
+	client.transport.TLSClientConfig = config
+	client.transport.CloseIdleConnections()
+
+	return nil
+}
+
+// SetProxy routes requests through the proxy at proxyUrl instead of the one
+// configured by the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment
+// variables. A nil proxyUrl disables proxying. Like SetTLSConfig, it is not
+// available on a client created with NewWithClient.
+func (client *Client) SetProxy(proxyUrl *url.URL) error {
+	if client.transport == nil {
+		return fmt.Errorf("cannot set proxy on a custom http.Client")
+	}
+
+	client.transport.Proxy = http.ProxyURL(proxyUrl)
+	client.transport.CloseIdleConnections()
+
+	return nil
+}
+
+// SetDeployment sets the kind of Jira instance the client talks to. It
+// defaults to DeploymentAuto, which detects it with ServerInfo; setting it
+// explicitly saves that request.
+func (client *Client) SetDeployment(deployment Deployment) {
+	client.deployment = deployment
+}
+
+// SetRequestTimeout bounds how long a request may take as a whole: sending
+// it, waiting for the answer, reading the response body and any retries.
+// This differs from the timeout given to NewClient, which only bounds
+// establishing the connection, so a server that accepts connections but
+// answers slowly is not caught by it. For streamed responses the limit
+// covers reading the stream until it is closed. Attachment downloads are
+// not bounded. Zero, the default, means no limit; a deadline on the
+// context passed to a request applies in addition.
+func (client *Client) SetRequestTimeout(timeout time.Duration) {
+	client.requestTimeout = timeout
+}
+
+// defaultMaxResponseBytes is the default limit on the size of a response
+// body read into memory.
+const defaultMaxResponseBytes = 32 << 20
+
+// ErrResponseTooLarge is returned when a response body exceeds the limit set
+// with SetMaxResponseBytes.
+var ErrResponseTooLarge = errors.New("response body too large")
+
+// SetMaxResponseBytes limits the size of response bodies read into memory,
+// 32MB by default, so an unexpectedly huge response can't exhaust memory.
+// Requests whose response exceeds it fail with ErrResponseTooLarge. Zero or
+// less removes the limit. Streamed responses, including attachment
+// downloads, are not limited.
+func (client *Client) SetMaxResponseBytes(maxBytes int64) {
+	client.maxResponseBytes = maxBytes
+}
+
+func (client *Client) GetIssue(key string, fields []string) (*Issue, error) {
+	return client.GetIssueContext(context.Background(), key, fields)
+}
+
+func (client *Client) GetIssueContext(ctx context.Context, key string,
+	fields []string) (*Issue, error) {
+	return client.GetIssueExpandedContext(ctx, key, fields, nil)
+}
+
+// GetIssueExpanded fetches an issue like GetIssue, additionally requesting
+// the given expansions, e.g. "renderedFields", "changelog", "transitions" or
+// "names". With "names", the issue's FieldNames is populated.
+func (client *Client) GetIssueExpanded(key string, fields []string,
+	expand []string) (*Issue, error) {
+	return client.GetIssueExpandedContext(context.Background(), key, fields,
+		expand)
+}
+
+func (client *Client) GetIssueExpandedContext(ctx context.Context,
+	key string, fields []string, expand []string) (*Issue, error) {
+	response, err := client.RequestWithContext(ctx, "GET",
+		issuePath(key, fields, expand), []byte{})
 	if err != nil {
 		return nil, err
 	}
@@ -81,88 +411,446 @@ func (client *Client) GetIssue(key string, fields []string) (
 		return nil, err
 	}
 
-	issue = &Issue{
-		Id:  rawData["id"].(string),
-		Key: rawData["key"].(string),
+	return newIssue(rawData)
+}
+
+// escapePath joins segments into a path, escaping each one so that it
+// remains a single segment whatever it contains, e.g. a slash in a key.
+func escapePath(segments ...string) string {
+	escaped := make([]string, len(segments))
+	for i, segment := range segments {
+		escaped[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(escaped, "/")
+}
+
+// issuePath returns the path of the issue key, restricted to fields and
+// with the given expansions. The key and query are escaped, so unusual
+// input can't alter the structure of the URL.
+func issuePath(key string, fields []string, expand []string) string {
+	query := url.Values{"fields": {strings.Join(fields, ",")}}
+	if len(expand) > 0 {
+		query.Set("expand", strings.Join(expand, ","))
+	}
+
+	return escapePath("issue", key) + "/?" + query.Encode()
+}
+
+// newIssue builds an Issue from an issue object as returned by the issue
+// and search endpoints.
+func newIssue(rawData map[string]interface{}) (*Issue, error) {
+	id, ok := rawData["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response: missing id field")
+	}
+	key, ok := rawData["key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response: missing key field")
+	}
+	data, ok := rawData["fields"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected response: missing fields field")
+	}
+
+	issue := &Issue{
+		Id:   id,
+		Key:  key,
+		Data: data,
 	}
 	tmp := strings.Split(issue.Key, "-")
 	issue.Project = strings.ToLower(tmp[0])
-	issue.Data = rawData["fields"].(map[string]interface{})
 
 	if summary, ok := issue.Data["summary"].(string); ok {
 		issue.Summary = summary
 	}
 
+	if names, ok := rawData["names"].(map[string]interface{}); ok {
+		issue.FieldNames = make(map[string]string, len(names))
+		for id, name := range names {
+			if name, ok := name.(string); ok {
+				issue.FieldNames[id] = name
+			}
+		}
+	}
+
 	return issue, nil
 }
 
-func (client *Client) GetProjectTitle(key string) (title string, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = r.(error)
-		}
-	}()
-	body, err := client.Request("GET", "project/"+key, []byte{})
+func (client *Client) GetProjectTitle(key string) (string, error) {
+	return client.GetProjectTitleContext(context.Background(), key)
+}
+
+func (client *Client) GetProjectTitleContext(ctx context.Context,
+	key string) (string, error) {
+	project, err := client.GetProjectContext(ctx, key)
 	if err != nil {
 		return "", err
 	}
-	var rawData interface{}
-	if err := json.Unmarshal(body, &rawData); err != nil {
-		return "", err
-	}
-	return rawData.(map[string]interface{})["name"].(string), nil
+	return project.Name, nil
 }
 
 func (client *Client) Comment(issue string, msg string) error {
+	return client.CommentContext(context.Background(), issue, msg)
+}
+
+func (client *Client) CommentContext(ctx context.Context, issue string,
+	msg string) error {
+	_, err := client.CommentWithResultContext(ctx, issue, msg)
+
+	return err
+}
+
+// CommentWithResult comments on issue like Comment and returns the new
+// comment, e.g. to later edit or delete it by its id.
+func (client *Client) CommentWithResult(issue string, msg string) (
+	*Comment, error) {
+	return client.CommentWithResultContext(context.Background(), issue, msg)
+}
+
+func (client *Client) CommentWithResultContext(ctx context.Context,
+	issue string, msg string) (*Comment, error) {
 	type comment struct {
 		Data string `json:"body"`
 	}
 
 	body, err := json.Marshal(comment{Data: msg})
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = client.Request("POST", "issue/"+issue+"/comment", body)
+	response, err := client.request(ctx, "POST", apiV2Path,
+		escapePath("issue", issue, "comment"), body, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	created := &Comment{}
+	if err := json.Unmarshal(response, created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
 }
 
 func (client *Client) Request(method string, path string, body []byte) (
 	[]byte, error) {
-	buffer := bytes.NewBuffer(body)
+	return client.RequestWithContext(context.Background(), method, path, body)
+}
+
+func (client *Client) RequestWithContext(ctx context.Context, method string,
+	path string, body []byte) ([]byte, error) {
+	return client.request(ctx, method, client.apiPath, path, body, nil)
+}
+
+// RequestWithHeaders is like Request but merges headers into the default
+// request headers, e.g. to override the Content-Type or to pass headers a
+// Jira plugin expects. The Authorization header can't be overridden.
+func (client *Client) RequestWithHeaders(method string, path string,
+	body []byte, headers http.Header) ([]byte, error) {
+	return client.RequestWithHeadersContext(context.Background(), method, path,
+		body, headers)
+}
+
+func (client *Client) RequestWithHeadersContext(ctx context.Context,
+	method string, path string, body []byte, headers http.Header) (
+	[]byte, error) {
+	return client.request(ctx, method, client.apiPath, path, body, headers)
+}
 
-	req, err := http.NewRequest(method, client.baseUrl.String()+path, buffer)
+// RequestStream is like Request but returns the body of a successful
+// response as a stream, along with the response headers, instead of reading
+// it into memory. Error responses are still returned as an Error. The caller
+// must close the stream.
+func (client *Client) RequestStream(method string, path string,
+	body []byte) (io.ReadCloser, http.Header, error) {
+	return client.RequestStreamWithContext(context.Background(), method, path,
+		body)
+}
+
+func (client *Client) RequestStreamWithContext(ctx context.Context,
+	method string, path string, body []byte) (io.ReadCloser, http.Header,
+	error) {
+	endpoint, err := client.endpoint(client.apiPath, path)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	req.Header.Add("Content-Type", "application/json; charset=utf-8")
-	req.SetBasicAuth(client.user, client.pass)
+	resp, stream, err := client.stream(ctx, method, endpoint, body, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return stream, resp.Header, nil
+}
 
-	resp, err := client.res.Do(req)
+// request sends a request to path below the API root at root and returns
+// the whole response body of a 2xx response. header is merged into the
+// default request headers, overriding them.
+func (client *Client) request(ctx context.Context, method string,
+	root string, path string, body []byte, header http.Header) (
+	[]byte, error) {
+	endpoint, err := client.endpoint(root, path)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	data, err := ioutil.ReadAll(resp.Body)
+	_, stream, err := client.stream(ctx, method, endpoint, body, header)
 	if err != nil {
 		return nil, err
 	}
+	defer stream.Close()
+
+	return client.readAll(stream)
+}
 
-	if resp.StatusCode == 404 {
-		return nil, Error{
-			StatusCode: resp.StatusCode, Status: resp.Status,
-			Message: "Not Found"}
+// readAll reads r to the end, failing with ErrResponseTooLarge once more
+// than the configured maximum has been read.
+func (client *Client) readAll(r io.Reader) ([]byte, error) {
+	if client.maxResponseBytes <= 0 {
+		return ioutil.ReadAll(r)
 	}
 
-	if resp.StatusCode >= 500 {
-		return nil, Error{StatusCode: resp.StatusCode,
-			Status: resp.Status, Message: string(data)}
+	data, err := ioutil.ReadAll(io.LimitReader(r, client.maxResponseBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > client.maxResponseBytes {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrResponseTooLarge,
+			client.maxResponseBytes)
 	}
 
 	return data, nil
 }
+
+// stream sends a request to the absolute URL endpoint, retrying it
+// according to the retry policy, and returns the response of the first
+// 2xx answer along with its (decompressed) body, which the caller must
+// close. Any other answer is returned as an Error.
+func (client *Client) stream(ctx context.Context, method string,
+	endpoint string, body []byte, header http.Header) (*http.Response,
+	io.ReadCloser, error) {
+	cancel := func() {}
+	if client.requestTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, client.requestTimeout)
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, stream, err := client.send(ctx, method, endpoint, body, header)
+		if err != nil {
+			cancel()
+			return nil, nil, err
+		}
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return resp, &cancelOnClose{stream, cancel}, nil
+		}
+
+		data, err := client.readAll(stream)
+		stream.Close()
+		if err != nil {
+			cancel()
+			return nil, nil, err
+		}
+
+		if !client.retry.allows(method, resp.StatusCode, attempt) {
+			cancel()
+			return nil, nil, newError(resp, data)
+		}
+
+		err = sleepContext(ctx, client.retry.delay(resp, attempt))
+		if err != nil {
+			cancel()
+			return nil, nil, err
+		}
+	}
+}
+
+// cancelOnClose releases the context of a request once its response body
+// is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (body *cancelOnClose) Close() error {
+	err := body.ReadCloser.Close()
+	body.cancel()
+	return err
+}
+
+// send performs a single attempt of a request and returns the response
+// along with its body, decompressed if needed. The request body is re-read
+// from the start on every call so it can be resent on retries.
+func (client *Client) send(ctx context.Context, method string,
+	endpoint string, body []byte, header http.Header) (*http.Response,
+	io.ReadCloser, error) {
+	req, err := client.newRequest(ctx, method, endpoint, body, header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := client.do(client.res, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream, err := responseBody(resp)
+	if err != nil {
+		resp.Body.Close()
+		return nil, nil, err
+	}
+
+	return resp, stream, nil
+}
+
+// do sends req with httpClient once the rate limit, if any, allows it and
+// reports it to the logger, if any.
+func (client *Client) do(httpClient *http.Client, req *http.Request) (
+	*http.Response, error) {
+	if client.limiter != nil {
+		if err := client.limiter.wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	if client.logger == nil {
+		return httpClient.Do(req)
+	}
+
+	start := time.Now()
+	resp, err := httpClient.Do(req)
+
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+
+	redacted := *req.URL
+	redacted.User = nil
+	client.logger(req.Method, redacted.String(), status, time.Since(start))
+
+	return resp, err
+}
+
+// newRequest builds an authenticated request to the absolute URL endpoint.
+func (client *Client) newRequest(ctx context.Context, method string,
+	endpoint string, body []byte, header http.Header) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint,
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("User-Agent", client.userAgent)
+	req.Header.Set("Accept-Encoding", "gzip")
+	if err := client.setRunAs(req); err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header.Del(name)
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+
+	switch {
+	case client.credentials != nil:
+		user, pass, err := client.credentials()
+		if err != nil {
+			return nil, err
+		}
+		req.SetBasicAuth(user, pass)
+	case client.auth == authBearer:
+		req.Header.Set("Authorization", "Bearer "+client.token)
+	default:
+		req.SetBasicAuth(client.user, client.pass)
+	}
+
+	return req, nil
+}
+
+// endpoint resolves path, which may carry a query string, against the API
+// root at root below the instance's base URL. Paths with "." or ".."
+// segments, escaped or not, are rejected, as they could point the request
+// at a different resource than intended, e.g. with a crafted issue key.
+func (client *Client) endpoint(root string, path string) (string, error) {
+	ref, err := url.Parse(root + path)
+	if err != nil {
+		return "", err
+	}
+	for _, segment := range strings.Split(ref.Path, "/") {
+		if segment == "." || segment == ".." {
+			return "", fmt.Errorf("invalid request path %q", path)
+		}
+	}
+
+	return client.baseUrl.ResolveReference(ref).String(), nil
+}
+
+// pageBounds returns the slice bounds of the page starting at startAt with
+// at most maxResults items out of total, for endpoints that return all items
+// at once and have to be paged locally. A maxResults of zero or less means
+// no limit.
+func pageBounds(startAt int, maxResults int, total int) (int, int) {
+	if startAt < 0 {
+		startAt = 0
+	}
+	if startAt > total {
+		startAt = total
+	}
+
+	end := total
+	if maxResults > 0 && startAt+maxResults < end {
+		end = startAt + maxResults
+	}
+
+	return startAt, end
+}
+
+// newError builds an Error from a non-2xx response. Jira reports general
+// messages in "errorMessages" and field-level validation messages in
+// "errors"; these are kept on the Error and flattened into Message. When the
+// body is not in that shape Message falls back to the raw body.
+func newError(resp *http.Response, data []byte) Error {
+	jiraErr := Error{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       data,
+	}
+	if resp.Request != nil {
+		jiraErr.Method = resp.Request.Method
+		jiraErr.URL = resp.Request.URL.String()
+	}
+
+	var rawData struct {
+		ErrorMessages []string          `json:"errorMessages"`
+		Errors        map[string]string `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &rawData); err == nil {
+		jiraErr.ErrorMessages = rawData.ErrorMessages
+		jiraErr.FieldErrors = rawData.Errors
+	}
+
+	fields := make([]string, 0, len(jiraErr.FieldErrors))
+	for field := range jiraErr.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	messages := append([]string{}, jiraErr.ErrorMessages...)
+	for _, field := range fields {
+		messages = append(messages, field+": "+jiraErr.FieldErrors[field])
+	}
+
+	switch {
+	case len(messages) > 0:
+		jiraErr.Message = strings.Join(messages, "; ")
+	case resp.StatusCode == 404:
+		jiraErr.Message = "Not Found"
+	default:
+		jiraErr.Message = string(data)
+	}
+
+	return jiraErr
+}
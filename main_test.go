@@ -0,0 +1,77 @@
+package jira
+
+import (
+	"testing"
+)
+
+func TestEndpoint(t *testing.T) {
+	tests := []struct {
+		jiraUrl string
+		want    string
+	}{
+		{"https://host", "https://host/rest/api/2/issue/X-1"},
+		{"https://host/", "https://host/rest/api/2/issue/X-1"},
+		{"https://host/context", "https://host/context/rest/api/2/issue/X-1"},
+		{"https://host/context/", "https://host/context/rest/api/2/issue/X-1"},
+		{"https://host/a/b", "https://host/a/b/rest/api/2/issue/X-1"},
+		{"https://host/a/b/", "https://host/a/b/rest/api/2/issue/X-1"},
+		{"https://host/context/rest/api/2",
+			"https://host/context/rest/api/2/issue/X-1"},
+		{"https://host/context/rest/api/2/",
+			"https://host/context/rest/api/2/issue/X-1"},
+		{"https://host/rest/api/2", "https://host/rest/api/2/issue/X-1"},
+		{"https://host/rest/api/3/", "https://host/rest/api/3/issue/X-1"},
+	}
+
+	for _, test := range tests {
+		client, err := NewClient(test.jiraUrl, "user", "pass", 0)
+		if err != nil {
+			t.Errorf("NewClient(%q): %v", test.jiraUrl, err)
+			continue
+		}
+
+		got, err := client.endpoint(client.apiPath, "issue/X-1")
+		if err != nil {
+			t.Errorf("%q: endpoint: %v", test.jiraUrl, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%q: got %q, want %q", test.jiraUrl, got, test.want)
+		}
+	}
+}
+
+func TestEndpointAgileUnderContextPath(t *testing.T) {
+	for _, jiraUrl := range []string{"https://host/jira", "https://host/jira/"} {
+		client, err := NewClient(jiraUrl, "user", "pass", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := client.endpoint(agilePath, "board")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "https://host/jira/rest/agile/1.0/board"; got != want {
+			t.Errorf("%q: got %q, want %q", jiraUrl, got, want)
+		}
+	}
+}
+
+func TestEndpointRejectsDotSegments(t *testing.T) {
+	client, err := NewClient("https://host/jira", "user", "pass", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paths := []string{
+		"issue/../project/FOO",
+		"issue/./X-1",
+		"issue/%2E%2E/project/FOO",
+	}
+	for _, path := range paths {
+		if got, err := client.endpoint(client.apiPath, path); err == nil {
+			t.Errorf("%q: got %q, want an error", path, got)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package jira
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+// IssuePickerSuggestion is an issue suggested by PickIssues. Section is the
+// label of the group Jira put it in, e.g. "History Search" or "Current
+// Search".
+type IssuePickerSuggestion struct {
+	Key         string `json:"key"`
+	SummaryText string `json:"summaryText"`
+	Section     string `json:"-"`
+}
+
+// PickIssues returns the issues Jira suggests for query, as used for issue
+// autocompletion: recently viewed issues and issues whose key or summary
+// match. currentProjectId, if not empty, favours issues of that project.
+// Jira groups the suggestions in sections; they are returned flattened, in
+// Jira's order. An issue may appear in more than one section.
+func (client *Client) PickIssues(query string, currentProjectId string) (
+	[]IssuePickerSuggestion, error) {
+	params := url.Values{"query": {query}}
+	if currentProjectId != "" {
+		params.Set("currentProjectId", currentProjectId)
+	}
+
+	response, err := client.Request("GET", "issue/picker?"+params.Encode(),
+		[]byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var rawData struct {
+		Sections []struct {
+			Label  string                  `json:"label"`
+			Issues []IssuePickerSuggestion `json:"issues"`
+		} `json:"sections"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return nil, err
+	}
+
+	var suggestions []IssuePickerSuggestion
+	for _, section := range rawData.Sections {
+		for _, issue := range section.Issues {
+			issue.Section = section.Label
+			suggestions = append(suggestions, issue)
+		}
+	}
+
+	return suggestions, nil
+}
@@ -0,0 +1,91 @@
+package jira
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// PingErrorKind classifies why Ping failed.
+type PingErrorKind int
+
+const (
+	// PingBadBaseUrl means the base URL does not lead to the REST API,
+	// e.g. because it points at the web UI.
+	PingBadBaseUrl PingErrorKind = iota + 1
+	// PingUnauthorized means the credentials were rejected (401).
+	PingUnauthorized
+	// PingForbidden means the credentials were accepted but access was
+	// denied (403).
+	PingForbidden
+	// PingNetwork means Jira could not be reached at all.
+	PingNetwork
+	// PingServerError means Jira answered with any other error.
+	PingServerError
+)
+
+// PingError is the error returned by Ping. Err is the underlying error.
+type PingError struct {
+	Kind    PingErrorKind
+	Message string
+	Err     error
+}
+
+func (e *PingError) Error() string {
+	return e.Message + ": " + e.Err.Error()
+}
+
+func (e *PingError) Unwrap() error {
+	return e.Err
+}
+
+// Ping checks that the base URL leads to the Jira REST API and that the
+// credentials are accepted, using a single authenticated request. Failures
+// are returned as a *PingError classifying the problem.
+func (client *Client) Ping() error {
+	response, err := client.Request("GET", "myself", []byte{})
+	if err == nil {
+		var rawData map[string]interface{}
+		if err := json.Unmarshal(response, &rawData); err != nil {
+			return badBaseUrl(response, err)
+		}
+		return nil
+	}
+
+	jiraErr, ok := err.(Error)
+	if !ok {
+		return &PingError{Kind: PingNetwork,
+			Message: "could not reach Jira", Err: err}
+	}
+
+	switch {
+	case jiraErr.StatusCode == 401:
+		return &PingError{Kind: PingUnauthorized,
+			Message: "authentication failed, check the credentials", Err: err}
+	case jiraErr.StatusCode == 403:
+		return &PingError{Kind: PingForbidden,
+			Message: "access denied, the user may lack permission or be " +
+				"locked out (e.g. by a CAPTCHA)", Err: err}
+	case jiraErr.StatusCode == 404 || isHTML(jiraErr.Body):
+		return badBaseUrl(jiraErr.Body, err)
+	}
+
+	return &PingError{Kind: PingServerError,
+		Message: "Jira returned an error", Err: err}
+}
+
+func badBaseUrl(body []byte, err error) *PingError {
+	message := "the base URL does not lead to the Jira REST API"
+	if isHTML(body) {
+		message = "got HTML instead of JSON: the base URL likely points at " +
+			"the web UI, not the REST API"
+	}
+
+	return &PingError{Kind: PingBadBaseUrl, Message: message, Err: err}
+}
+
+func isHTML(body []byte) bool {
+	body = bytes.ToLower(bytes.TrimSpace(body))
+
+	return bytes.HasPrefix(body, []byte("<!doctype html")) ||
+		bytes.HasPrefix(body, []byte("<html"))
+}
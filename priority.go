@@ -0,0 +1,26 @@
+package jira
+
+import (
+	"encoding/json"
+)
+
+type Priority struct {
+	Id      string `json:"id"`
+	Name    string `json:"name"`
+	IconUrl string `json:"iconUrl"`
+}
+
+// GetPriorities returns the issue priorities configured in Jira.
+func (client *Client) GetPriorities() ([]Priority, error) {
+	response, err := client.Request("GET", "priority", []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var priorities []Priority
+	if err := json.Unmarshal(response, &priorities); err != nil {
+		return nil, err
+	}
+
+	return priorities, nil
+}
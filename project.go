@@ -0,0 +1,149 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// getProjectsConcurrency is the number of projects GetProjects fetches at
+// the same time.
+const getProjectsConcurrency = 4
+
+type Project struct {
+	Id             string `json:"id"`
+	Key            string `json:"key"`
+	Name           string `json:"name"`
+	Lead           User   `json:"lead"`
+	ProjectTypeKey string `json:"projectTypeKey"`
+	Description    string `json:"description"`
+}
+
+func (client *Client) GetProject(key string) (*Project, error) {
+	return client.GetProjectContext(context.Background(), key)
+}
+
+func (client *Client) GetProjectContext(ctx context.Context, key string) (
+	*Project, error) {
+	body, err := client.RequestWithContext(ctx, "GET", escapePath("project", key),
+		[]byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	project := &Project{}
+	if err := json.Unmarshal(body, project); err != nil {
+		return nil, err
+	}
+	if project.Name == "" {
+		return nil, fmt.Errorf("unexpected response: missing name field")
+	}
+
+	return project, nil
+}
+
+// ListProjects returns one page of the projects visible to the user along
+// with the total number of projects. Jira Cloud pages the project list
+// server-side; Jira Server returns all projects at once, which are then
+// paged locally so both behave the same.
+func (client *Client) ListProjects(startAt int, maxResults int) (
+	[]*Project, int, error) {
+	cloud, err := client.isCloud()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	path := "project"
+	if cloud {
+		query := url.Values{
+			"startAt":    {strconv.Itoa(startAt)},
+			"maxResults": {strconv.Itoa(maxResults)},
+		}
+		path = "project/search?" + query.Encode()
+	}
+
+	response, err := client.Request("GET", path, []byte{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	response = bytes.TrimSpace(response)
+	if len(response) > 0 && response[0] == '{' {
+		var rawData struct {
+			Total  int        `json:"total"`
+			Values []*Project `json:"values"`
+		}
+		if err := json.Unmarshal(response, &rawData); err != nil {
+			return nil, 0, err
+		}
+		return rawData.Values, rawData.Total, nil
+	}
+
+	var projects []*Project
+	if err := json.Unmarshal(response, &projects); err != nil {
+		return nil, 0, err
+	}
+
+	start, end := pageBounds(startAt, maxResults, len(projects))
+
+	return projects[start:end], len(projects), nil
+}
+
+// GetProjects fetches the projects with the given keys and returns them by
+// key. Jira has no batch endpoint for this, so the projects are fetched
+// individually, a few at a time. A project that can't be fetched doesn't
+// stop the others: it is left out of the map and its failure is reported in
+// the returned error, which joins all failures.
+func (client *Client) GetProjects(keys []string) (map[string]*Project,
+	error) {
+	todo := make(chan string)
+	go func() {
+		defer close(todo)
+		seen := make(map[string]bool, len(keys))
+		for _, key := range keys {
+			if !seen[key] {
+				seen[key] = true
+				todo <- key
+			}
+		}
+	}()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	projects := make(map[string]*Project, len(keys))
+	failures := map[string]error{}
+
+	for i := 0; i < getProjectsConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range todo {
+				project, err := client.GetProject(key)
+
+				mu.Lock()
+				if err != nil {
+					failures[key] = err
+				} else {
+					projects[key] = project
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	var errs []error
+	for _, key := range keys {
+		if err, ok := failures[key]; ok {
+			errs = append(errs, fmt.Errorf("project %s: %w", key, err))
+			delete(failures, key)
+		}
+	}
+
+	return projects, errors.Join(errs...)
+}
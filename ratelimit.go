@@ -0,0 +1,67 @@
+package jira
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// SetRateLimit limits the client to rps requests per second on average,
+// allowing bursts of up to burst requests. Requests over the limit wait for
+// their turn, or until their context is done. A non-positive rps removes the
+// limit, which is the default.
+func (client *Client) SetRateLimit(rps float64, burst int) {
+	if rps <= 0 {
+		client.limiter = nil
+		return
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	client.limiter = &rateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// rateLimiter is a token bucket holding up to burst tokens, refilled at
+// rate tokens per second. Every request takes a token; when none is left
+// the request reserves the next one and waits until it is due.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func (limiter *rateLimiter) wait(ctx context.Context) error {
+	limiter.mu.Lock()
+	now := time.Now()
+	limiter.tokens += now.Sub(limiter.last).Seconds() * limiter.rate
+	if limiter.tokens > limiter.burst {
+		limiter.tokens = limiter.burst
+	}
+	limiter.last = now
+	limiter.tokens--
+
+	var delay time.Duration
+	if limiter.tokens < 0 {
+		delay = time.Duration(-limiter.tokens / limiter.rate *
+			float64(time.Second))
+	}
+	limiter.mu.Unlock()
+
+	if err := sleepContext(ctx, delay); err != nil {
+		// Give the reserved token back for other requests.
+		limiter.mu.Lock()
+		limiter.tokens++
+		limiter.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package jira
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// RemoteLink is a link from an issue to a resource outside Jira.
+type RemoteLink struct {
+	Id           int
+	Relationship string
+	Url          string
+	Title        string
+	IconUrl      string
+}
+
+// RemoteLinkOption sets optional properties of a remote link created by
+// AddRemoteLink.
+type RemoteLinkOption func(*remoteLink)
+
+// WithIconUrl sets the URL of a 16x16 icon shown next to the link.
+func WithIconUrl(iconUrl string) RemoteLinkOption {
+	return func(link *remoteLink) {
+		link.Object.Icon = &remoteLinkIcon{Url: iconUrl}
+	}
+}
+
+// WithRelationship sets the label the link is grouped under, e.g.
+// "mentioned in".
+func WithRelationship(relationship string) RemoteLinkOption {
+	return func(link *remoteLink) {
+		link.Relationship = relationship
+	}
+}
+
+type remoteLink struct {
+	Id           int              `json:"id,omitempty"`
+	Relationship string           `json:"relationship,omitempty"`
+	Object       remoteLinkObject `json:"object"`
+}
+
+type remoteLinkObject struct {
+	Url   string          `json:"url"`
+	Title string          `json:"title"`
+	Icon  *remoteLinkIcon `json:"icon,omitempty"`
+}
+
+type remoteLinkIcon struct {
+	Url string `json:"url16x16"`
+}
+
+// AddRemoteLink links issue to linkUrl under title and returns the id of the
+// new remote link.
+func (client *Client) AddRemoteLink(issue string, linkUrl string,
+	title string, opts ...RemoteLinkOption) (string, error) {
+	link := &remoteLink{Object: remoteLinkObject{Url: linkUrl, Title: title}}
+	for _, opt := range opts {
+		opt(link)
+	}
+
+	body, err := json.Marshal(link)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := client.Request("POST",
+		escapePath("issue", issue, "remotelink"), body)
+	if err != nil {
+		return "", err
+	}
+
+	var created struct {
+		Id int `json:"id"`
+	}
+	if err := json.Unmarshal(response, &created); err != nil {
+		return "", err
+	}
+
+	return strconv.Itoa(created.Id), nil
+}
+
+// GetRemoteLinks returns the remote links of issue.
+func (client *Client) GetRemoteLinks(issue string) ([]RemoteLink, error) {
+	response, err := client.Request("GET",
+		escapePath("issue", issue, "remotelink"), []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var rawLinks []remoteLink
+	if err := json.Unmarshal(response, &rawLinks); err != nil {
+		return nil, err
+	}
+
+	links := make([]RemoteLink, 0, len(rawLinks))
+	for _, rawLink := range rawLinks {
+		link := RemoteLink{
+			Id:           rawLink.Id,
+			Relationship: rawLink.Relationship,
+			Url:          rawLink.Object.Url,
+			Title:        rawLink.Object.Title,
+		}
+		if rawLink.Object.Icon != nil {
+			link.IconUrl = rawLink.Object.Icon.Url
+		}
+		links = append(links, link)
+	}
+
+	return links, nil
+}
@@ -0,0 +1,77 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+)
+
+// Get sends a GET request to path below the REST API root, like Request,
+// and decodes the JSON response into v. It is meant for endpoints the
+// client doesn't wrap yet. Any non-2xx response is returned as an Error.
+func (client *Client) Get(path string, v interface{}) error {
+	return client.GetContext(context.Background(), path, v)
+}
+
+func (client *Client) GetContext(ctx context.Context, path string,
+	v interface{}) error {
+	return client.doJSON(ctx, "GET", path, nil, v)
+}
+
+// Post sends body, encoded as JSON, in a POST request to path and decodes
+// the JSON response into v, like Get. A nil body sends no content; a nil v
+// discards the response.
+func (client *Client) Post(path string, body interface{},
+	v interface{}) error {
+	return client.PostContext(context.Background(), path, body, v)
+}
+
+func (client *Client) PostContext(ctx context.Context, path string,
+	body interface{}, v interface{}) error {
+	return client.doJSON(ctx, "POST", path, body, v)
+}
+
+// Put is like Post but sends a PUT request.
+func (client *Client) Put(path string, body interface{},
+	v interface{}) error {
+	return client.PutContext(context.Background(), path, body, v)
+}
+
+func (client *Client) PutContext(ctx context.Context, path string,
+	body interface{}, v interface{}) error {
+	return client.doJSON(ctx, "PUT", path, body, v)
+}
+
+// Delete sends a DELETE request to path.
+func (client *Client) Delete(path string) error {
+	return client.DeleteContext(context.Background(), path)
+}
+
+func (client *Client) DeleteContext(ctx context.Context, path string) error {
+	return client.doJSON(ctx, "DELETE", path, nil, nil)
+}
+
+// doJSON sends body as JSON and decodes the response into v, skipping
+// either if nil. Empty responses, such as 204 No Content, leave v as is.
+func (client *Client) doJSON(ctx context.Context, method string,
+	path string, body interface{}, v interface{}) error {
+	data := []byte{}
+	if body != nil {
+		var err error
+		data, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+	}
+
+	response, err := client.RequestWithContext(ctx, method, path, data)
+	if err != nil {
+		return err
+	}
+
+	if v == nil || len(bytes.TrimSpace(response)) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(response, v)
+}
@@ -0,0 +1,81 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type retryPolicy struct {
+	maxRetries int
+	baseDelay  time.Duration
+	allMethods bool
+}
+
+// SetRetryPolicy makes the client retry GET requests that fail with 429
+// Too Many Requests, 502 Bad Gateway or 503 Service Unavailable up to
+// maxRetries times. The delay between attempts doubles from baseDelay on
+// every retry, unless the response carries a Retry-After header, which is
+// honored instead. A maxRetries of zero disables retries.
+func (client *Client) SetRetryPolicy(maxRetries int, baseDelay time.Duration) {
+	client.retry.maxRetries = maxRetries
+	client.retry.baseDelay = baseDelay
+}
+
+// SetRetryAllMethods extends the retry policy to all request methods, not
+// just GET. Only enable it if retrying a non-idempotent request (e.g.
+// creating an issue or a comment) is acceptable.
+func (client *Client) SetRetryAllMethods(allMethods bool) {
+	client.retry.allMethods = allMethods
+}
+
+func (policy retryPolicy) allows(method string, status int,
+	attempt int) bool {
+	if attempt >= policy.maxRetries {
+		return false
+	}
+
+	if method != "GET" && !policy.allMethods {
+		return false
+	}
+
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway,
+		http.StatusServiceUnavailable:
+		return true
+	}
+
+	return false
+}
+
+func (policy retryPolicy) delay(resp *http.Response,
+	attempt int) time.Duration {
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			return time.Until(date)
+		}
+	}
+
+	return policy.baseDelay << uint(attempt)
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,71 @@
+package jira
+
+import (
+	"encoding/json"
+)
+
+// Actor is a member of a project role: a user or a group. Type is
+// "atlassian-user-role-actor" or "atlassian-group-role-actor". AccountId is
+// only set for users on Jira Cloud; Name is the username or group name.
+type Actor struct {
+	Type        string
+	DisplayName string
+	AccountId   string
+	Name        string
+}
+
+// GetProjectRoles returns the roles of the project projectKey, mapping each
+// role name to the URL of the role. The role id is the last segment of the
+// URL.
+func (client *Client) GetProjectRoles(projectKey string) (
+	map[string]string, error) {
+	response, err := client.Request("GET",
+		escapePath("project", projectKey, "role"), []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	roles := map[string]string{}
+	if err := json.Unmarshal(response, &roles); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// GetProjectRoleMembers returns the users and groups in the role roleId of
+// the project projectKey.
+func (client *Client) GetProjectRoleMembers(projectKey string,
+	roleId string) ([]Actor, error) {
+	response, err := client.Request("GET",
+		escapePath("project", projectKey, "role", roleId), []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var rawData struct {
+		Actors []struct {
+			Type        string `json:"type"`
+			Name        string `json:"name"`
+			DisplayName string `json:"displayName"`
+			ActorUser   struct {
+				AccountId string `json:"accountId"`
+			} `json:"actorUser"`
+		} `json:"actors"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return nil, err
+	}
+
+	actors := make([]Actor, 0, len(rawData.Actors))
+	for _, rawActor := range rawData.Actors {
+		actors = append(actors, Actor{
+			Type:        rawActor.Type,
+			DisplayName: rawActor.DisplayName,
+			AccountId:   rawActor.ActorUser.AccountId,
+			Name:        rawActor.Name,
+		})
+	}
+
+	return actors, nil
+}
@@ -0,0 +1,59 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SetRunAsHeader sets the name of the header SetRunAs and WithRunAs send
+// the account id of the impersonated user in. Jira itself has no
+// impersonation support; the header is meant for an impersonation-capable
+// app or authenticating proxy in front of Jira that honors it for trusted
+// service accounts, so its name depends on that app or proxy.
+func (client *Client) SetRunAsHeader(header string) {
+	client.runAsHeader = header
+}
+
+// SetRunAs makes every request act on behalf of the user with the given
+// account id (username on Jira Server) by sending it in the header set with
+// SetRunAsHeader, which must be set first. An empty accountId stops
+// impersonating. Like the other Set* methods it is meant for setting up the
+// client and must not be called while requests are in flight; to act for
+// different users on a shared client, use WithRunAs instead.
+func (client *Client) SetRunAs(accountId string) {
+	client.runAs = accountId
+}
+
+type runAsKey struct{}
+
+// WithRunAs returns a copy of ctx that makes requests made with it act on
+// behalf of the user with the given account id, overriding SetRunAs. An
+// empty accountId makes them act as the client's own user. It lets a
+// single long-lived Client serve many users concurrently through the
+// *Context methods, e.g. CreateIssueContext or DoTransitionContext.
+// The run-as header passed to RequestWithHeaders takes precedence over
+// both.
+func WithRunAs(ctx context.Context, accountId string) context.Context {
+	return context.WithValue(ctx, runAsKey{}, accountId)
+}
+
+// setRunAs sets the run-as header of req if the request impersonates
+// anyone, through its context or SetRunAs.
+func (client *Client) setRunAs(req *http.Request) error {
+	accountId, ok := req.Context().Value(runAsKey{}).(string)
+	if !ok {
+		accountId = client.runAs
+	}
+	if accountId == "" {
+		return nil
+	}
+	if client.runAsHeader == "" {
+		return fmt.Errorf("cannot run as %q: no run-as header set",
+			accountId)
+	}
+
+	req.Header.Set(client.runAsHeader, accountId)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithRunAs(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			got = append(got, r.Header.Get("X-Impersonate"))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "user", "pass", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetRunAsHeader("X-Impersonate")
+
+	ctx := WithRunAs(context.Background(), "alice")
+	if err := client.AddVoteContext(ctx, "X-1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.AddVote("X-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 2 || got[0] != "alice" || got[1] != "" {
+		t.Errorf("got run-as headers %q, want [alice \"\"]", got)
+	}
+}
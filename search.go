@@ -0,0 +1,272 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ErrMultipleMatches is returned by FindOne when more than one issue
+// matches.
+var ErrMultipleMatches = errors.New("multiple matches")
+
+// bulkGetBatchSize is the number of keys BulkGetIssues looks up per search,
+// keeping the JQL well below Jira's query length limits.
+const bulkGetBatchSize = 50
+
+// Search runs a JQL query and returns one page of matching issues along with
+// the total number of matches. Jira may return fewer than maxResults issues
+// per page (it caps the page size server-side), so callers paging through
+// results should advance startAt by len(issues); the result set is exhausted
+// once startAt+len(issues) >= total or a page comes back empty.
+func (client *Client) Search(jql string, fields []string, startAt int,
+	maxResults int) ([]*Issue, int, error) {
+	return client.SearchContext(context.Background(), jql, fields, startAt,
+		maxResults)
+}
+
+func (client *Client) SearchContext(ctx context.Context, jql string,
+	fields []string, startAt int, maxResults int) ([]*Issue, int, error) {
+	return client.search(ctx, map[string]interface{}{
+		"jql":        jql,
+		"fields":     fields,
+		"startAt":    startAt,
+		"maxResults": maxResults,
+	})
+}
+
+// FindOne returns the single issue matching jql. It returns ErrNotFound if
+// no issue matches and ErrMultipleMatches if more than one does.
+func (client *Client) FindOne(jql string, fields []string) (*Issue, error) {
+	issues, _, err := client.Search(jql, fields, 0, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(issues) {
+	case 0:
+		return nil, fmt.Errorf("no issue matching %q: %w", jql, ErrNotFound)
+	case 1:
+		return issues[0], nil
+	default:
+		return nil, fmt.Errorf("more than one issue matching %q: %w", jql,
+			ErrMultipleMatches)
+	}
+}
+
+// search posts query as the body of a search request and parses the
+// resulting page of issues.
+func (client *Client) search(ctx context.Context,
+	query map[string]interface{}) ([]*Issue, int, error) {
+	body, err := json.Marshal(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	response, err := client.RequestWithContext(ctx, "POST", "search", body)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return searchResults(response)
+}
+
+// SearchGET is like Search but sends the query in the URL of a GET request
+// instead of a JSON body, which is simpler for short queries and lets
+// proxies cache the results. Long queries may exceed URL length limits;
+// use Search for those.
+func (client *Client) SearchGET(jql string, fields []string, startAt int,
+	maxResults int) ([]*Issue, int, error) {
+	query := url.Values{
+		"jql":        {jql},
+		"startAt":    {strconv.Itoa(startAt)},
+		"maxResults": {strconv.Itoa(maxResults)},
+	}
+	if len(fields) > 0 {
+		query.Set("fields", strings.Join(fields, ","))
+	}
+
+	response, err := client.Request("GET", "search?"+query.Encode(),
+		[]byte{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return searchResults(response)
+}
+
+// searchResults parses a page of search results.
+func searchResults(response []byte) ([]*Issue, int, error) {
+	var rawData struct {
+		Total  int                      `json:"total"`
+		Issues []map[string]interface{} `json:"issues"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return nil, 0, err
+	}
+
+	issues := make([]*Issue, 0, len(rawData.Issues))
+	for _, rawIssue := range rawData.Issues {
+		issue, err := newIssue(rawIssue)
+		if err != nil {
+			return nil, 0, err
+		}
+		issues = append(issues, issue)
+	}
+
+	return issues, rawData.Total, nil
+}
+
+// defaultSearchPageSize is the page size SearchAll uses when none is given.
+const defaultSearchPageSize = 50
+
+// SearchAll runs a JQL query and yields every matching issue, fetching
+// pageSize issues at a time (50 if pageSize is not positive). The issue
+// channel is closed once all results have been delivered or paging stops;
+// afterwards the error channel yields the error that stopped it, if any,
+// and is closed too. Cancel ctx to stop early if the caller is no longer
+// reading issues.
+func (client *Client) SearchAll(ctx context.Context, jql string,
+	fields []string, pageSize int) (<-chan *Issue, <-chan error) {
+	issues := make(chan *Issue)
+	errs := make(chan error, 1)
+	if pageSize <= 0 {
+		pageSize = defaultSearchPageSize
+	}
+
+	go func() {
+		defer close(errs)
+		defer close(issues)
+
+		startAt := 0
+		for {
+			page, total, err := client.SearchContext(ctx, jql, fields,
+				startAt, pageSize)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, issue := range page {
+				select {
+				case issues <- issue:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			startAt += len(page)
+			if len(page) == 0 || startAt >= total {
+				return
+			}
+		}
+	}()
+
+	return issues, errs
+}
+
+// BulkGetIssues fetches the issues with the given keys using as few search
+// requests as possible. Issues are returned in the order of keys; keys that
+// do not exist or are not visible to the user are skipped, and issues found
+// under a different key (e.g. after a move) are appended at the end.
+func (client *Client) BulkGetIssues(keys []string, fields []string) (
+	[]*Issue, error) {
+	found := map[string]*Issue{}
+
+	for start := 0; start < len(keys); start += bulkGetBatchSize {
+		end := start + bulkGetBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		quoted := make([]string, 0, end-start)
+		for _, key := range keys[start:end] {
+			quoted = append(quoted, strconv.Quote(key))
+		}
+
+		issues, _, err := client.search(context.Background(),
+			map[string]interface{}{
+				"jql":           "key in (" + strings.Join(quoted, ",") + ")",
+				"fields":        fields,
+				"maxResults":    end - start,
+				"validateQuery": "warn",
+			})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, issue := range issues {
+			found[issue.Key] = issue
+		}
+	}
+
+	issues := make([]*Issue, 0, len(found))
+	for _, key := range keys {
+		if issue, ok := found[key]; ok {
+			issues = append(issues, issue)
+			delete(found, key)
+		}
+	}
+	for _, issue := range found {
+		issues = append(issues, issue)
+	}
+
+	return issues, nil
+}
+
+// SearchV3 runs a JQL query against Jira Cloud's cursor-paginated search
+// endpoint, which replaces startAt-based paging there. Pass an empty
+// nextPageToken for the first page, then the returned next token for each
+// following page until it comes back empty.
+func (client *Client) SearchV3(jql string, fields []string,
+	nextPageToken string, maxResults int) (issues []*Issue, next string,
+	err error) {
+	query := map[string]interface{}{
+		"jql":        jql,
+		"fields":     fields,
+		"maxResults": maxResults,
+	}
+	if nextPageToken != "" {
+		query["nextPageToken"] = nextPageToken
+	}
+
+	body, err := json.Marshal(query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	response, err := client.request(context.Background(), "POST",
+		apiV3Path, "search/jql", body, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var rawData struct {
+		Issues        []map[string]interface{} `json:"issues"`
+		NextPageToken string                   `json:"nextPageToken"`
+		IsLast        bool                     `json:"isLast"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return nil, "", err
+	}
+
+	issues = make([]*Issue, 0, len(rawData.Issues))
+	for _, rawIssue := range rawData.Issues {
+		issue, err := newIssue(rawIssue)
+		if err != nil {
+			return nil, "", err
+		}
+		issues = append(issues, issue)
+	}
+
+	if rawData.IsLast {
+		return issues, "", nil
+	}
+
+	return issues, rawData.NextPageToken, nil
+}
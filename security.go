@@ -0,0 +1,69 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SecurityLevel is an issue security level, restricting who can see an
+// issue.
+type SecurityLevel struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// GetSecurityLevels returns the security levels available in the project
+// projectKey. It fails if the project has no issue security scheme.
+func (client *Client) GetSecurityLevels(projectKey string) (
+	[]SecurityLevel, error) {
+	response, err := client.Request("GET",
+		escapePath("project", projectKey, "securitylevel"), []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var rawData struct {
+		Levels []SecurityLevel `json:"levels"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return nil, err
+	}
+	if len(rawData.Levels) == 0 {
+		return nil, fmt.Errorf("project %s has no issue security scheme",
+			projectKey)
+	}
+
+	return rawData.Levels, nil
+}
+
+// SetSecurityLevel sets the security level of the issue to the level named
+// levelName, ignoring case.
+func (client *Client) SetSecurityLevel(key string, levelName string) error {
+	issue, err := client.GetIssue(key, []string{"project"})
+	if err != nil {
+		return err
+	}
+
+	project, ok := issue.objectString("project", "key")
+	if !ok {
+		return fmt.Errorf("unexpected response: missing project field")
+	}
+
+	levels, err := client.GetSecurityLevels(project)
+	if err != nil {
+		return err
+	}
+
+	for _, level := range levels {
+		if strings.EqualFold(level.Name, levelName) {
+			return client.UpdateIssue(key, map[string]interface{}{
+				"security": map[string]string{"id": level.Id},
+			})
+		}
+	}
+
+	return fmt.Errorf("unknown security level %q in project %s", levelName,
+		project)
+}
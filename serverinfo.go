@@ -0,0 +1,55 @@
+package jira
+
+import (
+	"encoding/json"
+)
+
+// ServerInfo describes the Jira instance. DeploymentType is "Cloud" or
+// "Server".
+type ServerInfo struct {
+	BaseUrl        string `json:"baseUrl"`
+	Version        string `json:"version"`
+	VersionNumbers []int  `json:"versionNumbers"`
+	DeploymentType string `json:"deploymentType"`
+	BuildNumber    int    `json:"buildNumber"`
+	ServerTitle    string `json:"serverTitle"`
+}
+
+// ServerInfo returns information about the Jira instance. The result is
+// cached on the client after the first successful call.
+func (client *Client) ServerInfo() (*ServerInfo, error) {
+	client.serverInfoMu.Lock()
+	defer client.serverInfoMu.Unlock()
+
+	if client.serverInfo != nil {
+		return client.serverInfo, nil
+	}
+
+	response, err := client.Request("GET", "serverInfo", []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	info := &ServerInfo{}
+	if err := json.Unmarshal(response, info); err != nil {
+		return nil, err
+	}
+	client.serverInfo = info
+
+	return info, nil
+}
+
+// isCloud reports whether the client talks to Jira Cloud, detecting it with
+// ServerInfo if the deployment is DeploymentAuto.
+func (client *Client) isCloud() (bool, error) {
+	if client.deployment != DeploymentAuto {
+		return client.deployment == DeploymentCloud, nil
+	}
+
+	info, err := client.ServerInfo()
+	if err != nil {
+		return false, err
+	}
+
+	return info.DeploymentType == "Cloud", nil
+}
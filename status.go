@@ -0,0 +1,35 @@
+package jira
+
+import (
+	"encoding/json"
+)
+
+type Status struct {
+	Id             string         `json:"id"`
+	Name           string         `json:"name"`
+	StatusCategory StatusCategory `json:"statusCategory"`
+}
+
+// StatusCategory groups statuses into "new", "indeterminate" and "done";
+// ColorName is the color Jira renders the category in.
+type StatusCategory struct {
+	Id        int    `json:"id"`
+	Key       string `json:"key"`
+	Name      string `json:"name"`
+	ColorName string `json:"colorName"`
+}
+
+// GetStatuses returns all issue statuses configured in Jira.
+func (client *Client) GetStatuses() ([]Status, error) {
+	response, err := client.Request("GET", "status", []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []Status
+	if err := json.Unmarshal(response, &statuses); err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
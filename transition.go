@@ -0,0 +1,235 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// defaultCloseTransitionNames are the transition names CloseIssue looks for,
+// in order of preference, unless overridden by SetCloseTransitionNames.
+var defaultCloseTransitionNames = []string{
+	"Close", "Close Issue", "Done", "Resolve", "Resolve Issue",
+}
+
+// Transition is a workflow transition available from an issue's current
+// status.
+type Transition struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+
+	// Fields describes, by field id, the fields on the transition screen.
+	// It is only set by GetTransitionsExpanded.
+	Fields map[string]FieldMeta `json:"fields,omitempty"`
+}
+
+// GetTransitions returns the transitions available from the current status
+// of the issue.
+func (client *Client) GetTransitions(key string) ([]Transition, error) {
+	return client.getTransitions(context.Background(),
+		escapePath("issue", key, "transitions"))
+}
+
+// GetTransitionsExpanded is like GetTransitions but also describes the
+// fields of each transition's screen, including which are required, so
+// callers can ask for exactly the fields a transition needs.
+func (client *Client) GetTransitionsExpanded(key string) ([]Transition,
+	error) {
+	return client.getTransitions(context.Background(),
+		escapePath("issue", key, "transitions")+"?expand=transitions.fields")
+}
+
+func (client *Client) getTransitions(ctx context.Context, path string) (
+	[]Transition, error) {
+	response, err := client.RequestWithContext(ctx, "GET", path, []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var rawData struct {
+		Transitions []Transition `json:"transitions"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return nil, err
+	}
+
+	return rawData.Transitions, nil
+}
+
+// DoTransition moves the issue through the transition with the given id,
+// setting fields (e.g. the resolution) on the way, if any.
+func (client *Client) DoTransition(key string, transitionId string,
+	fields map[string]interface{}) error {
+	return client.DoTransitionContext(context.Background(), key, transitionId,
+		fields)
+}
+
+func (client *Client) DoTransitionContext(ctx context.Context, key string,
+	transitionId string, fields map[string]interface{}) error {
+	return client.transition(ctx, client.apiPath, key,
+		transitionPayload(transitionId, fields))
+}
+
+// TransitionWithComment moves the issue through the transition with the
+// given id and adds comment to it in the same request, so the comment is
+// only added if the transition succeeds, and vice versa.
+func (client *Client) TransitionWithComment(key string, transitionId string,
+	comment string, fields map[string]interface{}) error {
+	return client.TransitionWithCommentContext(context.Background(), key,
+		transitionId, comment, fields)
+}
+
+func (client *Client) TransitionWithCommentContext(ctx context.Context,
+	key string, transitionId string, comment string,
+	fields map[string]interface{}) error {
+	payload := transitionPayload(transitionId, fields)
+	payload["update"] = map[string]interface{}{
+		"comment": []interface{}{
+			map[string]interface{}{
+				"add": map[string]string{"body": comment},
+			},
+		},
+	}
+
+	return client.transition(ctx, apiV2Path, key, payload)
+}
+
+func transitionPayload(transitionId string,
+	fields map[string]interface{}) map[string]interface{} {
+	payload := map[string]interface{}{
+		"transition": map[string]string{"id": transitionId},
+	}
+	if len(fields) > 0 {
+		payload["fields"] = fields
+	}
+
+	return payload
+}
+
+// transition posts payload to the transitions of the issue below the API
+// root at root.
+func (client *Client) transition(ctx context.Context, root string,
+	key string, payload map[string]interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.request(ctx, "POST", root,
+		escapePath("issue", key, "transitions"), body, nil)
+
+	return err
+}
+
+// TransitionByName moves the issue through the transition whose name
+// matches name, ignoring case. It fails if no such transition is available
+// from the issue's current status.
+func (client *Client) TransitionByName(key string, name string) error {
+	return client.TransitionByNameContext(context.Background(), key, name)
+}
+
+func (client *Client) TransitionByNameContext(ctx context.Context, key string,
+	name string) error {
+	return client.transitionByName(ctx, key, name, nil)
+}
+
+func (client *Client) transitionByName(ctx context.Context, key string,
+	name string, fields map[string]interface{}) error {
+	transitions, err := client.getTransitions(ctx,
+		escapePath("issue", key, "transitions"))
+	if err != nil {
+		return err
+	}
+
+	for _, transition := range transitions {
+		if strings.EqualFold(transition.Name, name) {
+			return client.transition(ctx, client.apiPath, key,
+				transitionPayload(transition.Id, fields))
+		}
+	}
+
+	return fmt.Errorf("no transition %q available for %s", name, key)
+}
+
+// TransitionMany moves each of the issues keys through the transition named
+// transitionName, setting fields on the way, and returns the error of each
+// issue (nil on success) by key. The transition is looked up for every
+// issue, as its id can differ between workflows. Like CommentBatch, the
+// batch is aborted on a 401 response; the error that aborted it is then
+// returned as the second result and recorded for the issues that were not
+// transitioned.
+func (client *Client) TransitionMany(keys []string, transitionName string,
+	fields map[string]interface{}) (map[string]error, error) {
+	return client.TransitionManyContext(context.Background(), keys,
+		transitionName, fields)
+}
+
+func (client *Client) TransitionManyContext(ctx context.Context,
+	keys []string, transitionName string, fields map[string]interface{}) (
+	map[string]error, error) {
+	errs := make(map[string]error, len(keys))
+	abort := func(rest []string, err error) (map[string]error, error) {
+		for _, key := range rest {
+			if _, done := errs[key]; !done {
+				errs[key] = err
+			}
+		}
+		return errs, err
+	}
+
+	for i, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return abort(keys[i:], err)
+		}
+
+		err := client.transitionByName(ctx, key, transitionName, fields)
+		errs[key] = err
+
+		if jiraErr, ok := err.(Error); ok && jiraErr.StatusCode == 401 {
+			return abort(keys[i+1:], err)
+		}
+	}
+
+	return errs, nil
+}
+
+// SetCloseTransitionNames sets the transition names, in order of preference,
+// that CloseIssue looks for. Names are matched ignoring case.
+func (client *Client) SetCloseTransitionNames(names ...string) {
+	client.closeNames = names
+}
+
+// CloseIssue moves the issue through the first available transition named
+// like a closing one (see SetCloseTransitionNames), setting its resolution
+// to the resolution named resolution, unless that is empty.
+func (client *Client) CloseIssue(key string, resolution string) error {
+	transitions, err := client.GetTransitions(key)
+	if err != nil {
+		return err
+	}
+
+	var fields map[string]interface{}
+	if resolution != "" {
+		fields = map[string]interface{}{
+			"resolution": map[string]string{"name": resolution},
+		}
+	}
+
+	for _, name := range client.closeNames {
+		for _, transition := range transitions {
+			if strings.EqualFold(transition.Name, name) {
+				return client.DoTransition(key, transition.Id, fields)
+			}
+		}
+	}
+
+	available := make([]string, 0, len(transitions))
+	for _, transition := range transitions {
+		available = append(available, strconv.Quote(transition.Name))
+	}
+
+	return fmt.Errorf("no closing transition available for %s (available: %s)",
+		key, strings.Join(available, ", "))
+}
@@ -0,0 +1,168 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// User is a Jira user as embedded in issues, comments and other resources.
+// Jira Cloud identifies users by AccountId, while Jira Server uses Name.
+type User struct {
+	AccountId    string `json:"accountId,omitempty"`
+	Name         string `json:"name,omitempty"`
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress,omitempty"`
+	Active       bool   `json:"active"`
+	TimeZone     string `json:"timeZone,omitempty"`
+}
+
+// Myself returns the user the client is authenticated as. It also serves as
+// a cheap check of the credentials: an Error with StatusCode 401 means they
+// were rejected.
+func (client *Client) Myself() (*User, error) {
+	response, err := client.Request("GET", "myself", []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	user := &User{}
+	if err := json.Unmarshal(response, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// MyTimeZone returns the time zone of the user the client is authenticated
+// as, e.g. to display dates in it. The result is cached on the client after
+// the first successful call.
+func (client *Client) MyTimeZone() (*time.Location, error) {
+	client.timeZoneMu.Lock()
+	defer client.timeZoneMu.Unlock()
+
+	if client.timeZone != nil {
+		return client.timeZone, nil
+	}
+
+	user, err := client.Myself()
+	if err != nil {
+		return nil, err
+	}
+	if user.TimeZone == "" {
+		return nil, fmt.Errorf("unexpected response: missing timeZone field")
+	}
+
+	location, err := time.LoadLocation(user.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	client.timeZone = location
+
+	return location, nil
+}
+
+// FindAssignableUsers returns users matching query who can be assigned
+// issues in the project projectKey or, if issueKey is set, the issue
+// issueKey. One of the two must be given.
+func (client *Client) FindAssignableUsers(query string, projectKey string,
+	issueKey string, maxResults int) ([]User, error) {
+	if projectKey == "" && issueKey == "" {
+		return nil, fmt.Errorf("either a project or an issue key is required")
+	}
+
+	cloud, err := client.isCloud()
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if cloud {
+		params.Set("query", query)
+	} else {
+		params.Set("username", query)
+	}
+	if issueKey != "" {
+		params.Set("issueKey", issueKey)
+	} else {
+		params.Set("project", projectKey)
+	}
+	if maxResults > 0 {
+		params.Set("maxResults", strconv.Itoa(maxResults))
+	}
+
+	response, err := client.Request("GET",
+		"user/assignable/search?"+params.Encode(), []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var users []User
+	if err := json.Unmarshal(response, &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// ResolveAccountId returns the account id of the user with the given
+// username or email address, for methods that need an account id on Jira
+// Cloud. On Jira Server, which has no account ids, the username is returned
+// instead, which is what those methods expect there. A user whose username,
+// email address or account id equals usernameOrEmail, ignoring case, is
+// preferred over other users the search finds. It returns ErrNotFound if no
+// user matches and ErrMultipleMatches if it can't tell which user is meant.
+func (client *Client) ResolveAccountId(usernameOrEmail string) (string,
+	error) {
+	cloud, err := client.isCloud()
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	if cloud {
+		params.Set("query", usernameOrEmail)
+	} else {
+		params.Set("username", usernameOrEmail)
+	}
+
+	response, err := client.Request("GET", "user/search?"+params.Encode(),
+		[]byte{})
+	if err != nil {
+		return "", err
+	}
+
+	var users []User
+	if err := json.Unmarshal(response, &users); err != nil {
+		return "", err
+	}
+
+	var exact []User
+	for _, user := range users {
+		if strings.EqualFold(user.EmailAddress, usernameOrEmail) ||
+			strings.EqualFold(user.Name, usernameOrEmail) ||
+			user.AccountId == usernameOrEmail {
+			exact = append(exact, user)
+		}
+	}
+	if len(exact) > 0 {
+		users = exact
+	}
+
+	switch len(users) {
+	case 0:
+		return "", fmt.Errorf("no user matching %q: %w", usernameOrEmail,
+			ErrNotFound)
+	case 1:
+		if cloud {
+			return users[0].AccountId, nil
+		}
+		return users[0].Name, nil
+	default:
+		return "", fmt.Errorf("%d users match %q: %w", len(users),
+			usernameOrEmail, ErrMultipleMatches)
+	}
+}
@@ -0,0 +1,174 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// ValidationError lists every problem ValidateIssue found with an issue.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid issue: " + strings.Join(e.Problems, "; ")
+}
+
+// ValidateIssue checks the arguments of a CreateIssue call against the
+// project's create screen without creating anything: required fields must
+// be set, every field must be on the screen and values of fields with a
+// fixed set of allowed values must be among them. All problems found are
+// returned together as a *ValidationError.
+func (client *Client) ValidateIssue(project string, issuetype string,
+	summary string, fields map[string]interface{}) error {
+	meta, err := client.createMetaFields(project, issuetype)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{}
+	for name, value := range fields {
+		data[name] = value
+	}
+	data["project"] = map[string]string{"key": project}
+	data["issuetype"] = map[string]string{"name": issuetype}
+	data["summary"] = summary
+
+	metaIds := make([]string, 0, len(meta))
+	for id := range meta {
+		metaIds = append(metaIds, id)
+	}
+	sort.Strings(metaIds)
+
+	dataIds := make([]string, 0, len(data))
+	for id := range data {
+		dataIds = append(dataIds, id)
+	}
+	sort.Strings(dataIds)
+
+	var problems []string
+	for _, id := range metaIds {
+		field := meta[id]
+		if _, ok := data[id]; !ok && field.Required &&
+			!field.HasDefaultValue {
+			problems = append(problems,
+				fmt.Sprintf("required field %s (%s) is missing", id, field.Name))
+		}
+	}
+	for _, id := range dataIds {
+		field, ok := meta[id]
+		switch {
+		case !ok:
+			problems = append(problems,
+				fmt.Sprintf("field %s is not on the create screen", id))
+		case id != "project" && id != "issuetype" &&
+			len(field.AllowedValues) > 0 &&
+			!allowedValue(field.AllowedValues, data[id]):
+			problems = append(problems,
+				fmt.Sprintf("value of field %s (%s) is not allowed", id,
+					field.Name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+
+	return nil
+}
+
+// createMetaFields returns the fields of the create screen of issuetype in
+// project.
+func (client *Client) createMetaFields(project string, issuetype string) (
+	map[string]FieldMeta, error) {
+	query := url.Values{
+		"projectKeys":    {project},
+		"issuetypeNames": {issuetype},
+		"expand":         {"projects.issuetypes.fields"},
+	}
+	response, err := client.Request("GET",
+		"issue/createmeta?"+query.Encode(), []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var rawData struct {
+		Projects []struct {
+			IssueTypes []struct {
+				Fields map[string]FieldMeta `json:"fields"`
+			} `json:"issuetypes"`
+		} `json:"projects"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return nil, err
+	}
+
+	if len(rawData.Projects) == 0 {
+		return nil, fmt.Errorf("cannot create issues in project %s", project)
+	}
+	if len(rawData.Projects[0].IssueTypes) == 0 {
+		return nil, fmt.Errorf("cannot create %q issues in project %s",
+			issuetype, project)
+	}
+
+	return rawData.Projects[0].IssueTypes[0].Fields, nil
+}
+
+// allowedValue reports whether value, or each element of it if it is a
+// slice, matches one of allowed by id, name or value.
+func allowedValue(allowed []interface{}, value interface{}) bool {
+	if values, ok := value.([]interface{}); ok {
+		for _, value := range values {
+			if !allowedValue(allowed, value) {
+				return false
+			}
+		}
+		return true
+	}
+	if values, ok := value.([]string); ok {
+		for _, value := range values {
+			if !allowedValue(allowed, value) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, candidate := range allowed {
+		option, ok := candidate.(map[string]interface{})
+		if !ok {
+			if candidate == value {
+				return true
+			}
+			continue
+		}
+
+		for _, key := range []string{"id", "name", "value", "key"} {
+			if want, ok := option[key]; ok && matchesOption(value, key, want) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matchesOption reports whether value, either a bare string or an object
+// such as {"id": "10000"}, refers to the option whose key is want.
+func matchesOption(value interface{}, key string, want interface{}) bool {
+	switch value := value.(type) {
+	case string:
+		return value == fmt.Sprint(want)
+	case map[string]interface{}:
+		got, ok := value[key]
+		return ok && fmt.Sprint(got) == fmt.Sprint(want)
+	case map[string]string:
+		got, ok := value[key]
+		return ok && got == fmt.Sprint(want)
+	}
+
+	return false
+}
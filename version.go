@@ -0,0 +1,103 @@
+package jira
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Version is a project version (release). ReleaseDate is a date in the
+// form "2006-01-02", empty if not set.
+type Version struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Released    bool   `json:"released"`
+	Archived    bool   `json:"archived"`
+	StartDate   string `json:"startDate,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+}
+
+// VersionOption sets optional properties of a version created by
+// CreateVersion.
+type VersionOption func(*versionPayload)
+
+// WithVersionDescription sets the description of the version.
+func WithVersionDescription(description string) VersionOption {
+	return func(version *versionPayload) {
+		version.Description = description
+	}
+}
+
+// WithStartDate sets the date work on the version starts.
+func WithStartDate(startDate time.Time) VersionOption {
+	return func(version *versionPayload) {
+		version.StartDate = startDate.Format(dateLayout)
+	}
+}
+
+type versionPayload struct {
+	Project     string `json:"project"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	StartDate   string `json:"startDate,omitempty"`
+}
+
+// GetProjectVersions returns all versions of the project projectKey.
+func (client *Client) GetProjectVersions(projectKey string) (
+	[]Version, error) {
+	response, err := client.Request("GET",
+		escapePath("project", projectKey, "versions"), []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []Version
+	if err := json.Unmarshal(response, &versions); err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// CreateVersion creates an unreleased version named name in the project
+// projectKey.
+func (client *Client) CreateVersion(projectKey string, name string,
+	opts ...VersionOption) (*Version, error) {
+	payload := &versionPayload{Project: projectKey, Name: name}
+	for _, opt := range opts {
+		opt(payload)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.Request("POST", "version", body)
+	if err != nil {
+		return nil, err
+	}
+
+	version := &Version{}
+	if err := json.Unmarshal(response, version); err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// ReleaseVersion marks the version as released on releaseDate.
+func (client *Client) ReleaseVersion(versionId string,
+	releaseDate time.Time) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"released":    true,
+		"releaseDate": releaseDate.Format(dateLayout),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Request("PUT", escapePath("version", versionId), body)
+
+	return err
+}
@@ -0,0 +1,68 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrVotingDisabled is returned by the vote methods when Jira answers 404,
+// which it does when voting is disabled (and also when the issue does not
+// exist or is not visible to the user). The Error Jira returned is wrapped
+// along with it.
+var ErrVotingDisabled = errors.New("voting is disabled")
+
+// AddVote votes for issue as the current user.
+func (client *Client) AddVote(issue string) error {
+	return client.AddVoteContext(context.Background(), issue)
+}
+
+func (client *Client) AddVoteContext(ctx context.Context, issue string) error {
+	_, err := client.RequestWithContext(ctx, "POST",
+		escapePath("issue", issue, "votes"), []byte{})
+
+	return votingError(issue, err)
+}
+
+// RemoveVote withdraws the current user's vote for issue.
+func (client *Client) RemoveVote(issue string) error {
+	return client.RemoveVoteContext(context.Background(), issue)
+}
+
+func (client *Client) RemoveVoteContext(ctx context.Context,
+	issue string) error {
+	_, err := client.RequestWithContext(ctx, "DELETE",
+		escapePath("issue", issue, "votes"), []byte{})
+
+	return votingError(issue, err)
+}
+
+// GetVotes returns the number of votes for issue and whether the current
+// user is among the voters.
+func (client *Client) GetVotes(issue string) (count int, hasVoted bool,
+	err error) {
+	response, err := client.Request("GET",
+		escapePath("issue", issue, "votes"), []byte{})
+	if err != nil {
+		return 0, false, votingError(issue, err)
+	}
+
+	var rawData struct {
+		Votes    int  `json:"votes"`
+		HasVoted bool `json:"hasVoted"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return 0, false, err
+	}
+
+	return rawData.Votes, rawData.HasVoted, nil
+}
+
+func votingError(issue string, err error) error {
+	if jiraErr, ok := err.(Error); ok && jiraErr.StatusCode == 404 {
+		return fmt.Errorf("%s: %w: %w", issue, ErrVotingDisabled, err)
+	}
+
+	return err
+}
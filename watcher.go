@@ -0,0 +1,89 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// AddWatcher adds the user with the given account id (username on Jira
+// Server) to the watchers of issue.
+func (client *Client) AddWatcher(issue string, accountId string) error {
+	return client.AddWatcherContext(context.Background(), issue, accountId)
+}
+
+func (client *Client) AddWatcherContext(ctx context.Context, issue string,
+	accountId string) error {
+	body, err := json.Marshal(accountId)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.RequestWithContext(ctx, "POST",
+		escapePath("issue", issue, "watchers"), body)
+
+	return err
+}
+
+// RemoveWatcher removes the user with the given account id (username on
+// Jira Server) from the watchers of issue.
+func (client *Client) RemoveWatcher(issue string, accountId string) error {
+	return client.RemoveWatcherContext(context.Background(), issue, accountId)
+}
+
+func (client *Client) RemoveWatcherContext(ctx context.Context, issue string,
+	accountId string) error {
+	cloud, err := client.isCloud()
+	if err != nil {
+		return err
+	}
+
+	param := "username"
+	if cloud {
+		param = "accountId"
+	}
+	query := url.Values{param: {accountId}}
+
+	_, err = client.RequestWithContext(ctx, "DELETE",
+		escapePath("issue", issue, "watchers")+"?"+query.Encode(), []byte{})
+
+	return err
+}
+
+// GetWatchers returns the users watching issue.
+func (client *Client) GetWatchers(issue string) ([]User, error) {
+	response, err := client.Request("GET",
+		escapePath("issue", issue, "watchers"), []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var rawData struct {
+		Watchers []User `json:"watchers"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return nil, err
+	}
+
+	return rawData.Watchers, nil
+}
+
+// WatcherCount returns the number of users watching issue. It decodes only
+// the count, which is cheaper than GetWatchers when the users themselves
+// are not needed.
+func (client *Client) WatcherCount(issue string) (int, error) {
+	response, err := client.Request("GET",
+		escapePath("issue", issue, "watchers"), []byte{})
+	if err != nil {
+		return 0, err
+	}
+
+	var rawData struct {
+		WatchCount int `json:"watchCount"`
+	}
+	if err := json.Unmarshal(response, &rawData); err != nil {
+		return 0, err
+	}
+
+	return rawData.WatchCount, nil
+}
@@ -0,0 +1,163 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Worklog is time logged against an issue.
+type Worklog struct {
+	Id               string `json:"id"`
+	IssueId          string `json:"issueId"`
+	TimeSpentSeconds int    `json:"timeSpentSeconds"`
+	Started          string `json:"started"`
+	Author           User   `json:"author"`
+	Comment          string `json:"comment"`
+}
+
+// AddWorklog logs timeSpentSeconds of work on issue, started at started.
+func (client *Client) AddWorklog(issue string, timeSpentSeconds int,
+	started time.Time, comment string) (*Worklog, error) {
+	return client.AddWorklogContext(context.Background(), issue,
+		timeSpentSeconds, started, comment)
+}
+
+func (client *Client) AddWorklogContext(ctx context.Context, issue string,
+	timeSpentSeconds int, started time.Time, comment string) (*Worklog,
+	error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"timeSpentSeconds": timeSpentSeconds,
+		"started":          started.Format(timeLayout),
+		"comment":          comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.request(ctx, "POST",
+		apiV2Path, escapePath("issue", issue, "worklog"), body, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	worklog := &Worklog{}
+	if err := json.Unmarshal(response, worklog); err != nil {
+		return nil, err
+	}
+
+	return worklog, nil
+}
+
+// GetWorklogs returns all worklogs of issue, fetching as many pages as
+// needed.
+func (client *Client) GetWorklogs(issue string) ([]Worklog, error) {
+	worklogs := []Worklog{}
+	for {
+		query := url.Values{"startAt": {strconv.Itoa(len(worklogs))}}
+		response, err := client.request(context.Background(), "GET",
+			apiV2Path, escapePath("issue", issue, "worklog")+"?"+
+				query.Encode(), []byte{}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var rawData struct {
+			Total    int       `json:"total"`
+			Worklogs []Worklog `json:"worklogs"`
+		}
+		if err := json.Unmarshal(response, &rawData); err != nil {
+			return nil, err
+		}
+
+		worklogs = append(worklogs, rawData.Worklogs...)
+		if len(rawData.Worklogs) == 0 || len(worklogs) >= rawData.Total {
+			return worklogs, nil
+		}
+	}
+}
+
+// worklogListBatchSize is the number of worklogs GetWorklogsByIds fetches
+// per request, the most Jira accepts.
+const worklogListBatchSize = 1000
+
+// WorklogRef refers to a worklog that was created or updated, as returned
+// by WorklogsUpdatedSince. UpdatedTime is in milliseconds since the epoch.
+type WorklogRef struct {
+	WorklogId   int   `json:"worklogId"`
+	UpdatedTime int64 `json:"updatedTime"`
+}
+
+// WorklogsUpdatedSince returns references to all worklogs, of any issue,
+// created or updated since since, fetching as many pages as needed. Use
+// GetWorklogsByIds to fetch the worklogs themselves. Remembering the latest
+// UpdatedTime allows syncing worklogs incrementally. A zero since, or one
+// before the epoch, returns all worklogs.
+func (client *Client) WorklogsUpdatedSince(since time.Time) ([]WorklogRef,
+	error) {
+	refs := []WorklogRef{}
+	var sinceMillis int64
+	if !since.IsZero() && since.After(time.Unix(0, 0)) {
+		sinceMillis = since.UnixMilli()
+	}
+	for {
+		query := url.Values{
+			"since": {strconv.FormatInt(sinceMillis, 10)},
+		}
+		response, err := client.Request("GET",
+			"worklog/updated?"+query.Encode(), []byte{})
+		if err != nil {
+			return nil, err
+		}
+
+		var rawData struct {
+			Values   []WorklogRef `json:"values"`
+			Until    int64        `json:"until"`
+			LastPage bool         `json:"lastPage"`
+		}
+		if err := json.Unmarshal(response, &rawData); err != nil {
+			return nil, err
+		}
+
+		refs = append(refs, rawData.Values...)
+		if rawData.LastPage || len(rawData.Values) == 0 ||
+			rawData.Until <= sinceMillis {
+			return refs, nil
+		}
+		sinceMillis = rawData.Until
+	}
+}
+
+// GetWorklogsByIds returns the worklogs with the given ids, e.g. as
+// returned by WorklogsUpdatedSince. Worklogs that don't exist or the user
+// may not see are left out.
+func (client *Client) GetWorklogsByIds(ids []int) ([]Worklog, error) {
+	worklogs := []Worklog{}
+	for start := 0; start < len(ids); start += worklogListBatchSize {
+		end := start + worklogListBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		body, err := json.Marshal(map[string][]int{"ids": ids[start:end]})
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := client.request(context.Background(), "POST",
+			apiV2Path, "worklog/list", body, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var batch []Worklog
+		if err := json.Unmarshal(response, &batch); err != nil {
+			return nil, err
+		}
+		worklogs = append(worklogs, batch...)
+	}
+
+	return worklogs, nil
+}
@@ -0,0 +1,40 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWorklogsUpdatedSince(t *testing.T) {
+	var since string
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			since = r.URL.Query().Get("since")
+			w.Write([]byte(`{"values": [], "lastPage": true}`))
+		}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "user", "pass", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		since time.Time
+		want  string
+	}{
+		{time.Time{}, "0"},
+		{time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC), "0"},
+		{time.Unix(1700000000, 123456789), "1700000000123"},
+	} {
+		if _, err := client.WorklogsUpdatedSince(test.since); err != nil {
+			t.Fatal(err)
+		}
+		if since != test.want {
+			t.Errorf("WorklogsUpdatedSince(%v) sent since=%s, want %s",
+				test.since, since, test.want)
+		}
+	}
+}